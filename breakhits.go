@@ -0,0 +1,80 @@
+package gogt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BreakHits is the number of punches needed to break a block. The game
+// stores it as one byte holding six times the hit count; values that are
+// not a multiple of six are kept as they are and reported as raw.
+//
+// Its text form is the hit count, or the raw byte followed by "r".
+type BreakHits struct {
+	raw uint8
+}
+
+// NewBreakHits returns BreakHits for a block broken in hits punches.
+func NewBreakHits(hits int) (BreakHits, error) {
+	if hits < 0 || hits*6 > 0xFF {
+		return BreakHits{}, fmt.Errorf("break hits %d out of range 0-%d", hits, 0xFF/6)
+	}
+	return BreakHits{raw: uint8(hits * 6)}, nil
+}
+
+// RawBreakHits returns BreakHits holding the stored byte raw.
+func RawBreakHits(raw uint8) BreakHits {
+	return BreakHits{raw: raw}
+}
+
+// Raw returns the stored byte.
+func (b BreakHits) Raw() uint8 {
+	return b.raw
+}
+
+// Hits returns the number of punches, the stored byte divided by six.
+func (b BreakHits) Hits() int {
+	return int(b.raw) / 6
+}
+
+// IsRaw reports whether the stored byte is not a multiple of six, so that
+// Hits does not describe it exactly.
+func (b BreakHits) IsRaw() bool {
+	return b.raw%6 != 0
+}
+
+func (b BreakHits) String() string {
+	if b.IsRaw() {
+		return strconv.Itoa(int(b.raw)) + "r"
+	}
+	return strconv.Itoa(b.Hits())
+}
+
+func (b BreakHits) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText accepts a hit count such as "3", or a raw byte with an "r"
+// suffix such as "184r".
+func (b *BreakHits) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	if raw, ok := strings.CutSuffix(s, "r"); ok {
+		n, err := strconv.ParseUint(raw, 10, 8)
+		if err != nil {
+			return fmt.Errorf("invalid raw break hits %q", s)
+		}
+		*b = RawBreakHits(uint8(n))
+		return nil
+	}
+	hits, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("invalid break hits %q", s)
+	}
+	v, err := NewBreakHits(hits)
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
@@ -0,0 +1,57 @@
+package gogt
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBreakHitsText(t *testing.T) {
+	tests := []struct {
+		text  string
+		raw   uint8
+		hits  int
+		isRaw bool
+		out   string
+	}{
+		{"0", 0, 0, false, "0"},
+		{"3", 18, 3, false, "3"},
+		{"184r", 184, 30, true, "184r"},
+		{"18r", 18, 3, false, "3"},
+		{" 7 ", 42, 7, false, "7"},
+	}
+	for _, tt := range tests {
+		var b BreakHits
+		if err := b.UnmarshalText([]byte(tt.text)); err != nil {
+			t.Errorf("UnmarshalText(%q): %v", tt.text, err)
+			continue
+		}
+		if b.Raw() != tt.raw || b.Hits() != tt.hits || b.IsRaw() != tt.isRaw {
+			t.Errorf("UnmarshalText(%q) = raw %d hits %d isRaw %v", tt.text, b.Raw(), b.Hits(), b.IsRaw())
+		}
+		if got := b.String(); got != tt.out {
+			t.Errorf("UnmarshalText(%q).String() = %q, want %q", tt.text, got, tt.out)
+		}
+	}
+}
+
+func TestBreakHitsInvalid(t *testing.T) {
+	for _, text := range []string{"", "abc", "r", "256r", "-1", "43", "1.5"} {
+		var b BreakHits
+		if err := b.UnmarshalText([]byte(text)); err == nil {
+			t.Errorf("UnmarshalText(%q) = %v, want error", text, b)
+		}
+	}
+}
+
+func TestBreakHitsJSON(t *testing.T) {
+	var item Item
+	if err := json.Unmarshal([]byte(`{"break_hits":"abc"}`), &item); err == nil {
+		t.Fatal("invalid break_hits decoded without error")
+	}
+	if err := json.Unmarshal([]byte(`{"break_hits":"184r"}`), &item); err != nil {
+		t.Fatal(err)
+	}
+	if item.BreakHits.Raw() != 184 {
+		t.Fatalf("Raw() = %d, want 184", item.BreakHits.Raw())
+	}
+}
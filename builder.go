@@ -0,0 +1,178 @@
+package gogt
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// An ItemBuilder describes a new item and its seed for ItemsData.AddItem.
+// Its methods return the builder so that calls can be chained:
+//
+//	itemsData.AddItem(gogt.NewItem("Magic Block").
+//		Kind(gogt.ActionForeground).
+//		Texture("tiles_page1.rttex", 4, 2).
+//		Rarity(25))
+type ItemBuilder struct {
+	block Item
+	seed  Item
+	err   error
+}
+
+// NewItem starts a block item called name. Unless changed, the block is a
+// solid foreground that breaks in three hits and stacks to 200, and its seed
+// is called name followed by " Seed".
+func NewItem(name string) *ItemBuilder {
+	b := &ItemBuilder{
+		block: Item{
+			ActionType:    ActionForeground,
+			Name:          name,
+			CollisionType: CollisionSolid,
+			MaxAmount:     200,
+		},
+		seed: Item{
+			ActionType: ActionSeed,
+			Name:       name + " Seed",
+			MaxAmount:  200,
+		},
+	}
+	return b.BreakHits(3)
+}
+
+// Kind sets the block's ActionType. Seeds are added along with their block
+// and cannot be built on their own.
+func (b *ItemBuilder) Kind(action ActionType) *ItemBuilder {
+	if action == ActionSeed {
+		b.setErr(fmt.Errorf("item %q: seeds are created with their block", b.block.Name))
+	}
+	b.block.ActionType = action
+	return b
+}
+
+// Texture sets the texture file and the tile position in it for the block
+// and its seed.
+func (b *ItemBuilder) Texture(file string, x, y int) *ItemBuilder {
+	for _, item := range []*Item{&b.block, &b.seed} {
+		item.Texture = file
+		item.TextureX = x
+		item.TextureY = y
+	}
+	return b
+}
+
+// Rarity sets the rarity of the block and its seed.
+func (b *ItemBuilder) Rarity(rarity int) *ItemBuilder {
+	b.block.Rarity = rarity
+	b.seed.Rarity = rarity
+	return b
+}
+
+// Collision sets the block's CollisionType.
+func (b *ItemBuilder) Collision(collision CollisionType) *ItemBuilder {
+	b.block.CollisionType = collision
+	return b
+}
+
+// Clothing makes the block a clothing item worn in slot.
+func (b *ItemBuilder) Clothing(slot ClothingType) *ItemBuilder {
+	b.block.ActionType = ActionClothes
+	b.block.ClothingType = slot
+	b.block.CollisionType = CollisionNone
+	return b
+}
+
+// BreakHits sets the number of punches needed to break the block.
+func (b *ItemBuilder) BreakHits(hits int) *ItemBuilder {
+	bh, err := NewBreakHits(hits)
+	if err != nil {
+		b.setErr(fmt.Errorf("item %q: %w", b.block.Name, err))
+	}
+	b.block.BreakHits = bh
+	return b
+}
+
+// MaxAmount sets how many of the block fit in one inventory slot.
+func (b *ItemBuilder) MaxAmount(n int) *ItemBuilder {
+	b.block.MaxAmount = n
+	return b
+}
+
+// Flags sets the block's flags.
+func (b *ItemBuilder) Flags(f Flags) *ItemBuilder {
+	b.block.SetFlags(f)
+	return b
+}
+
+// Seed sets how the seed and the tree it grows into are drawn. The block
+// carries the same values.
+func (b *ItemBuilder) Seed(seedBase, seedOverlay, treeBase, treeLeaves int, seedColor, seedOverlayColor Color) *ItemBuilder {
+	for _, item := range []*Item{&b.block, &b.seed} {
+		item.SeedBase = seedBase
+		item.SeedOverlay = seedOverlay
+		item.TreeBase = treeBase
+		item.TreeLeaves = treeLeaves
+		item.SeedColor = seedColor
+		item.SeedOverlayColor = seedOverlayColor
+	}
+	return b
+}
+
+// GrowTime sets how many seconds the tree takes to grow.
+func (b *ItemBuilder) GrowTime(seconds int) *ItemBuilder {
+	b.block.GrowTime = seconds
+	b.seed.GrowTime = seconds
+	return b
+}
+
+// With calls fn on the block and on its seed, for fields the other methods
+// do not cover. The item's ID is assigned by AddItem afterwards.
+func (b *ItemBuilder) With(fn func(block, seed *Item)) *ItemBuilder {
+	fn(&b.block, &b.seed)
+	return b
+}
+
+func (b *ItemBuilder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// AddItem appends the block described by b at the next free even ID, and
+// its seed right after it, and updates ItemCount. Fields that itemsData's
+// version does not store are cleared. It returns the block's ID.
+func (itemsData *ItemsData) AddItem(b *ItemBuilder) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	if itemsData.Version < 0 || itemsData.Version > LatestVersion {
+		return 0, fmt.Errorf("%w: %d", ErrUnsupportedVersion, itemsData.Version)
+	}
+
+	id := 0
+	for i := range itemsData.Items {
+		if itemsData.Items[i].ItemID >= id {
+			id = itemsData.Items[i].ItemID + 1
+		}
+	}
+	id += id % 2
+
+	block, seed := b.block, b.seed
+	block.ItemID, seed.ItemID = id, id+1
+	clearMissing(&block, itemsData.Version)
+	clearMissing(&seed, itemsData.Version)
+
+	itemsData.Items = append(itemsData.Items, block, seed)
+	itemsData.ItemCount = len(itemsData.Items)
+	return id, nil
+}
+
+// clearMissing zeroes the fields of item that a version items.dat file
+// does not store.
+func clearMissing(item *Item, version int) {
+	v := reflect.ValueOf(item).Elem()
+	for _, f := range itemSchema {
+		if f.minVersion > version {
+			fv := v.Field(f.index)
+			fv.Set(reflect.Zero(fv.Type()))
+		}
+	}
+}
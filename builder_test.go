@@ -0,0 +1,75 @@
+package gogt
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAddItem(t *testing.T) {
+	itemsData := seedFixture()
+	itemsData.Version = 11
+	itemsData.ItemCount = len(itemsData.Items)
+
+	id, err := itemsData.AddItem(NewItem("Magic Block").
+		Texture("tiles_page9.rttex", 4, 2).
+		Rarity(25).
+		GrowTime(3600).
+		With(func(block, seed *Item) {
+			block.PunchOptions = "punch"
+			block.StrVersion22 = "too new"
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 4 || itemsData.ItemCount != 6 || len(itemsData.Items) != 6 {
+		t.Fatalf("id %d, ItemCount %d, %d items", id, itemsData.ItemCount, len(itemsData.Items))
+	}
+
+	block, ok := itemsData.ByID(4)
+	if !ok || block.Name != "Magic Block" || block.Rarity != 25 || block.BreakHits.Hits() != 3 {
+		t.Fatalf("block = %+v", block)
+	}
+	if block.PunchOptions != "punch" || block.StrVersion22 != "" {
+		t.Errorf("version 11 block has PunchOptions %q, StrVersion22 %q", block.PunchOptions, block.StrVersion22)
+	}
+	seed, ok := itemsData.SeedOf(4)
+	if !ok || seed.Name != "Magic Block Seed" || seed.GrowTime != 3600 || seed.Texture != "tiles_page9.rttex" {
+		t.Fatalf("seed = %+v", seed)
+	}
+	if errs := itemsData.CheckSeedPairs(); len(errs) != 0 {
+		t.Fatalf("CheckSeedPairs() = %v", errs)
+	}
+	if _, err := Decode(mustEncode(t, itemsData)); err != nil {
+		t.Fatalf("decoding the result: %v", err)
+	}
+}
+
+func TestAddItemNextEvenID(t *testing.T) {
+	itemsData := &ItemsData{Version: LatestVersion, Items: []Item{{ItemID: 0}, {ItemID: 1, ActionType: ActionSeed}, {ItemID: 2}}}
+	id, err := itemsData.AddItem(NewItem("After a missing seed"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 4 {
+		t.Fatalf("AddItem picked ID %d, want 4", id)
+	}
+}
+
+func TestAddItemErrors(t *testing.T) {
+	itemsData := &ItemsData{Version: LatestVersion}
+	if _, err := itemsData.AddItem(NewItem("Seed").Kind(ActionSeed)); err == nil {
+		t.Error("AddItem accepted a seed")
+	}
+	if _, err := itemsData.AddItem(NewItem("Tough").BreakHits(100)); err == nil {
+		t.Error("AddItem accepted 100 break hits")
+	}
+	for _, version := range []int{-1, LatestVersion + 1} {
+		itemsData.Version = version
+		if _, err := itemsData.AddItem(NewItem("Future")); !errors.Is(err, ErrUnsupportedVersion) {
+			t.Errorf("AddItem on version %d: %v", version, err)
+		}
+	}
+	if len(itemsData.Items) != 0 {
+		t.Errorf("failed AddItem calls left %d items", len(itemsData.Items))
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/yoruakio/gogrowtools"
+)
+
+func diffCommand(args []string) {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	formatPtr := fs.String("format", "text", "Output format: text, json or markdown")
+	bigEndianPtr := fs.Bool("big-endian", false, "Read big-endian fields (legacy files)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: gogt diff [flags] old new")
+		fmt.Fprintln(fs.Output(), "Compares two items.dat, .json or .txt files.")
+		fs.PrintDefaults()
+	}
+	paths := parseArgs(fs, args)
+	if len(paths) != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	opts := byteOrderOpts(*bigEndianPtr)
+	oldData, err := readItemsData(paths[0], opts)
+	if err != nil {
+		fail("Error reading", paths[0]+":", err)
+	}
+	newData, err := readItemsData(paths[1], opts)
+	if err != nil {
+		fail("Error reading", paths[1]+":", err)
+	}
+
+	changes := gogt.Diff(oldData, newData)
+	w := bufio.NewWriter(os.Stdout)
+	switch *formatPtr {
+	case "text":
+		writeDiffText(w, oldData, newData, changes)
+	case "json":
+		if changes == nil {
+			changes = []gogt.ItemChange{}
+		}
+		data, err := json.MarshalIndent(changes, "", "  ")
+		if err != nil {
+			fail("Error encoding diff:", err)
+		}
+		w.Write(append(data, '\n'))
+	case "markdown", "md":
+		writeDiffMarkdown(w, oldData, newData, changes)
+	default:
+		fail("Unsupported diff format:", *formatPtr)
+	}
+	if err := w.Flush(); err != nil {
+		fail("Error writing diff:", err)
+	}
+}
+
+func writeDiffText(w io.Writer, oldData, newData *gogt.ItemsData, changes []gogt.ItemChange) {
+	if oldData.Version != newData.Version {
+		fmt.Fprintf(w, "version: %d -> %d\n", oldData.Version, newData.Version)
+	}
+	counts := map[gogt.ChangeKind]int{}
+	for _, c := range changes {
+		counts[c.Kind]++
+		switch c.Kind {
+		case gogt.ChangeAdded:
+			fmt.Fprintf(w, "+ %d %s\n", c.ItemID, c.Name)
+		case gogt.ChangeRemoved:
+			fmt.Fprintf(w, "- %d %s\n", c.ItemID, c.Name)
+		default:
+			fmt.Fprintf(w, "~ %d %s\n", c.ItemID, c.Name)
+			for _, f := range c.Fields {
+				fmt.Fprintf(w, "    %s: %s -> %s\n", f.Field, gogt.FormatValue(f.Old), gogt.FormatValue(f.New))
+			}
+		}
+	}
+	fmt.Fprintf(w, "%d added, %d removed, %d changed\n", counts[gogt.ChangeAdded], counts[gogt.ChangeRemoved], counts[gogt.ChangeChanged])
+}
+
+func writeDiffMarkdown(w io.Writer, oldData, newData *gogt.ItemsData, changes []gogt.ItemChange) {
+	fmt.Fprintln(w, "# items.dat changes")
+	if oldData.Version != newData.Version {
+		fmt.Fprintf(w, "\nVersion %d → %d\n", oldData.Version, newData.Version)
+	}
+	for _, section := range []struct {
+		kind    gogt.ChangeKind
+		heading string
+	}{{gogt.ChangeAdded, "Added"}, {gogt.ChangeRemoved, "Removed"}} {
+		var rows []string
+		for _, c := range changes {
+			if c.Kind == section.kind {
+				rows = append(rows, fmt.Sprintf("| %d | %s |", c.ItemID, markdownCell(c.Name)))
+			}
+		}
+		if rows == nil {
+			continue
+		}
+		fmt.Fprintf(w, "\n## %s (%d)\n\n| ID | Name |\n|---:|---|\n", section.heading, len(rows))
+		fmt.Fprintln(w, strings.Join(rows, "\n"))
+	}
+
+	var rows []string
+	n := 0
+	for _, c := range changes {
+		if c.Kind != gogt.ChangeChanged {
+			continue
+		}
+		n++
+		for _, f := range c.Fields {
+			rows = append(rows, fmt.Sprintf("| %d | %s | %s | %s | %s |", c.ItemID, markdownCell(c.Name), f.Field,
+				markdownCell(gogt.FormatValue(f.Old)), markdownCell(gogt.FormatValue(f.New))))
+		}
+	}
+	if n > 0 {
+		fmt.Fprintf(w, "\n## Changed (%d)\n\n| ID | Name | Field | Old | New |\n|---:|---|---|---|---|\n", n)
+		fmt.Fprintln(w, strings.Join(rows, "\n"))
+	}
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "\nNo changes.")
+	}
+}
+
+// markdownCell escapes s for use inside a table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
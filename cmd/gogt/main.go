@@ -0,0 +1,329 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yoruakio/gogrowtools"
+)
+
+// stdio is the path that stands for stdin or stdout.
+const stdio = "-"
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "diff":
+			diffCommand(os.Args[2:])
+			return
+		case "patch":
+			patchCommand(os.Args[2:])
+			return
+		}
+	}
+
+	encodePtr := flag.Bool("encode", false, "Encode a .json, .txt or binary file into items.dat")
+	decodePtr := flag.Bool("decode", false, "Decode items.dat into .json or .txt")
+	getInfoPrt := flag.Bool("info", false, "Get information about items.dat")
+	hashPtr := flag.Bool("hash", false, "Print the client file hash of items.dat")
+	validatePtr := flag.Bool("validate", false, "Check items.dat, .json or .txt for problems the client rejects")
+	inPtr := flag.String("in", "", "Input file, or - for stdin")
+	filePathPtr := flag.String("file", "", "Alias for -in")
+	outPtr := flag.String("out", "", "Output file, or - for stdout")
+	oPtr := flag.String("o", "", "Alias for -out")
+	formatPtr := flag.String("format", "", "Output format for -decode: json or txt (default from -out extension, else json)")
+	bigEndianPtr := flag.Bool("big-endian", false, "Read and write big-endian fields (legacy files)")
+	truncatePtr := flag.Bool("truncate", false, "With -encode, truncate integers too wide for their field instead of failing")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "Usage: gogt -encode|-decode|-info|-hash|-validate -in file [flags]")
+		fmt.Fprintln(flag.CommandLine.Output(), "       gogt diff [flags] old new")
+		fmt.Fprintln(flag.CommandLine.Output(), "       gogt patch create|apply [flags] ...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var opts []gogt.Option
+	if *bigEndianPtr {
+		opts = append(opts, gogt.WithByteOrder(binary.BigEndian))
+	}
+	if *truncatePtr {
+		opts = append(opts, gogt.Truncate())
+	}
+
+	modes := 0
+	for _, m := range []bool{*encodePtr, *decodePtr, *getInfoPrt, *hashPtr, *validatePtr} {
+		if m {
+			modes++
+		}
+	}
+	if modes != 1 {
+		fail("Please choose exactly one of -encode, -decode, -info, -hash or -validate.")
+	}
+
+	inPath := *inPtr
+	if inPath == "" {
+		inPath = *filePathPtr
+	}
+	if inPath == "" {
+		fail("Please provide an input file using the -in flag.")
+	}
+	outPath := *outPtr
+	if outPath == "" {
+		outPath = *oPtr
+	}
+
+	if *encodePtr {
+		encodeItems(inPath, outPath, opts)
+	} else if *decodePtr {
+		decodeItems(inPath, outPath, *formatPtr, opts)
+	} else if *hashPtr {
+		hashItems(inPath)
+	} else if *validatePtr {
+		validateItems(inPath, opts)
+	} else {
+		getItemsInfo(inPath, opts)
+	}
+}
+
+func fail(a ...any) {
+	fmt.Fprintln(os.Stderr, a...)
+	os.Exit(1)
+}
+
+func getItemsInfo(inPath string, opts []gogt.Option) {
+	r, err := openInput(inPath)
+	if err != nil {
+		fail("Error reading file:", err)
+	}
+	defer r.Close()
+
+	version, itemCount, err := gogt.NewDecoder(r, opts...).Header()
+	if err != nil {
+		fail("Error decoding items.dat:", err)
+	}
+
+	fmt.Println("Version:", version)
+	fmt.Println("Item count:", itemCount)
+}
+
+// hashItems prints the hash of the file as stored, without decoding it, so
+// the value matches what the client computes over its copy.
+func hashItems(inPath string) {
+	r, err := openInput(inPath)
+	if err != nil {
+		fail("Error reading file:", err)
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		fail("Error reading file:", err)
+	}
+
+	fmt.Println("Hash:", gogt.FileHash(data))
+}
+
+// validateItems prints every issue found and exits with status 1 if there
+// are any.
+func validateItems(inPath string, opts []gogt.Option) {
+	itemsData, err := readItemsData(inPath, opts)
+	if err != nil {
+		fail("Error reading items data:", err)
+	}
+
+	issues := itemsData.Validate()
+	for _, issue := range issues {
+		fmt.Println(issue)
+	}
+	if len(issues) > 0 {
+		fail(len(issues), "issues found.")
+	}
+	fmt.Println("No issues found.")
+}
+
+func encodeItems(inPath, outPath string, opts []gogt.Option) {
+	if outPath == "" {
+		outPath = defaultOutput(inPath, ".dat")
+	}
+	checkOverwrite(inPath, outPath)
+
+	itemsData, err := readItemsData(inPath, opts)
+	if err != nil {
+		fail("Error reading items data:", err)
+	}
+
+	encodedData, err := gogt.Encode(itemsData, opts...)
+	if err != nil {
+		fail("Error encoding items.dat:", err)
+	}
+
+	err = writeOutput(outPath, func(w io.Writer) error {
+		_, err := w.Write(encodedData)
+		return err
+	})
+	if err != nil {
+		fail("Error writing to file:", err)
+	}
+
+	status(outPath, "Items.dat encoded successfully!")
+}
+
+func decodeItems(inPath, outPath, format string, opts []gogt.Option) {
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(outPath), ".")
+		if format != "json" && format != "txt" {
+			format = "json"
+		}
+	}
+	if format != "json" && format != "txt" {
+		fail("Unsupported output format:", format)
+	}
+	if outPath == "" {
+		outPath = defaultOutput(inPath, "."+format)
+	}
+	checkOverwrite(inPath, outPath)
+
+	itemsData, err := readItemsData(inPath, opts)
+	if err != nil {
+		fail("Error decoding items.dat:", err)
+	}
+
+	err = writeOutput(outPath, func(w io.Writer) error {
+		return writeItemsData(w, itemsData, format)
+	})
+	if err != nil {
+		fail("Error writing decoded data:", err)
+	}
+
+	status(outPath, "Items.dat decoded successfully!")
+}
+
+// readItemsData loads a decoded .json or .txt file, or decodes a binary
+// items.dat for anything else. Input without a telling extension, such as
+// stdin, is recognised by its first bytes.
+func readItemsData(inPath string, opts []gogt.Option) (*gogt.ItemsData, error) {
+	r, err := openInput(inPath)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	switch detectFormat(inPath, data) {
+	case "json":
+		itemsData := &gogt.ItemsData{}
+		if err := json.Unmarshal(data, itemsData); err != nil {
+			return nil, err
+		}
+		return itemsData, nil
+	case "txt":
+		return gogt.ReadText(bytes.NewReader(data))
+	}
+	return gogt.Decode(data, opts...)
+}
+
+func writeItemsData(w io.Writer, itemsData *gogt.ItemsData, format string) error {
+	if format == "json" {
+		data, err := json.MarshalIndent(itemsData, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+	return gogt.WriteText(w, itemsData)
+}
+
+func detectFormat(path string, data []byte) string {
+	switch filepath.Ext(path) {
+	case ".json":
+		return "json"
+	case ".txt":
+		return "txt"
+	case ".dat":
+		return "dat"
+	}
+	trimmed := bytes.TrimLeft(data, " \t\r\n")
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		return "json"
+	case bytes.HasPrefix(trimmed, []byte("//")), bytes.HasPrefix(trimmed, []byte("version\\")):
+		return "txt"
+	}
+	return "dat"
+}
+
+func openInput(path string) (io.ReadCloser, error) {
+	if path == stdio {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return os.Open(path)
+}
+
+// writeOutput writes to a temporary file next to path and renames it into
+// place, so a failed run never leaves a half-written output behind. The
+// output keeps the mode of the file it replaces, or gets 0644 if new.
+func writeOutput(path string, write func(io.Writer) error) error {
+	if path == stdio {
+		return write(os.Stdout)
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	mode := os.FileMode(0644)
+	if fi, err := os.Stat(path); err == nil {
+		mode = fi.Mode().Perm()
+	}
+	if err := f.Chmod(mode); err != nil {
+		f.Close()
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func checkOverwrite(inPath, outPath string) {
+	if inPath != stdio && outPath != stdio && filepath.Clean(inPath) == filepath.Clean(outPath) {
+		fail("Refusing to overwrite the input file", inPath+"; choose a different -out.")
+	}
+}
+
+// defaultOutput derives an output path from inPath by swapping its
+// extension. It never picks a file that already exists; use -out to
+// overwrite one deliberately.
+func defaultOutput(inPath, ext string) string {
+	if inPath == stdio {
+		return stdio
+	}
+	outPath := strings.TrimSuffix(inPath, filepath.Ext(inPath)) + ext
+	if _, err := os.Stat(outPath); err == nil {
+		fail(outPath, "already exists; choose an output file with -out.")
+	}
+	return outPath
+}
+
+func status(outPath, msg string) {
+	if outPath == stdio {
+		fmt.Fprintln(os.Stderr, msg)
+		return
+	}
+	fmt.Println(msg)
+}
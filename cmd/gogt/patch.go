@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yoruakio/gogrowtools"
+)
+
+func patchCommand(args []string) {
+	if len(args) > 0 {
+		switch args[0] {
+		case "create":
+			patchCreate(args[1:])
+			return
+		case "apply":
+			patchApply(args[1:])
+			return
+		}
+	}
+	fmt.Fprintln(os.Stderr, "Usage: gogt patch create [flags] base modified")
+	fmt.Fprintln(os.Stderr, "       gogt patch apply [flags] base patch.json")
+	os.Exit(2)
+}
+
+func patchCreate(args []string) {
+	fs := flag.NewFlagSet("patch create", flag.ExitOnError)
+	outPtr := fs.String("o", stdio, "Output patch file, or - for stdout")
+	bigEndianPtr := fs.Bool("big-endian", false, "Read big-endian fields (legacy files)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: gogt patch create [flags] base modified")
+		fmt.Fprintln(fs.Output(), "Records the changes from base to modified as a JSON patch.")
+		fs.PrintDefaults()
+	}
+	paths := parseArgs(fs, args)
+	if len(paths) != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	opts := byteOrderOpts(*bigEndianPtr)
+
+	base, err := readItemsData(paths[0], opts)
+	if err != nil {
+		fail("Error reading", paths[0]+":", err)
+	}
+	modified, err := readItemsData(paths[1], opts)
+	if err != nil {
+		fail("Error reading", paths[1]+":", err)
+	}
+	p, err := gogt.CreatePatch(base, modified)
+	if err != nil {
+		fail("Error creating patch:", err)
+	}
+
+	err = writeOutput(*outPtr, func(w io.Writer) error {
+		data, err := json.MarshalIndent(p, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(data, '\n'))
+		return err
+	})
+	if err != nil {
+		fail("Error writing patch:", err)
+	}
+	status(*outPtr, fmt.Sprintf("Patch created with %d edits and %d added items.", len(p.Edits), len(p.Add)))
+}
+
+// patchApply writes the patched file even when there are conflicts, with
+// the conflicting edits left out, and then exits with status 1.
+func patchApply(args []string) {
+	fs := flag.NewFlagSet("patch apply", flag.ExitOnError)
+	outPtr := fs.String("o", "", "Output file, or - for stdout; .json and .txt are written decoded")
+	bigEndianPtr := fs.Bool("big-endian", false, "Read and write big-endian fields (legacy files)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: gogt patch apply [flags] base patch.json -o out.dat")
+		fmt.Fprintln(fs.Output(), "Applies a JSON patch to base, reporting edits that conflict with upstream changes.")
+		fs.PrintDefaults()
+	}
+	paths := parseArgs(fs, args)
+	if len(paths) != 2 || *outPtr == "" {
+		fs.Usage()
+		os.Exit(2)
+	}
+	checkOverwrite(paths[0], *outPtr)
+	opts := byteOrderOpts(*bigEndianPtr)
+
+	itemsData, err := readItemsData(paths[0], opts)
+	if err != nil {
+		fail("Error reading", paths[0]+":", err)
+	}
+	r, err := openInput(paths[1])
+	if err != nil {
+		fail("Error reading patch:", err)
+	}
+	var p gogt.Patch
+	err = json.NewDecoder(r).Decode(&p)
+	r.Close()
+	if err != nil {
+		fail("Error reading patch:", err)
+	}
+
+	conflicts, err := itemsData.ApplyPatch(&p)
+	if err != nil {
+		fail("Error applying patch:", err)
+	}
+
+	format := strings.TrimPrefix(filepath.Ext(*outPtr), ".")
+	err = writeOutput(*outPtr, func(w io.Writer) error {
+		if format == "json" || format == "txt" {
+			return writeItemsData(w, itemsData, format)
+		}
+		data, err := gogt.Encode(itemsData, opts...)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	})
+	if err != nil {
+		fail("Error writing patched file:", err)
+	}
+
+	for _, c := range conflicts {
+		fmt.Fprintln(os.Stderr, "conflict:", c)
+	}
+	if len(conflicts) > 0 {
+		fail(len(conflicts), "conflicts; the conflicting changes were not applied.")
+	}
+	status(*outPtr, "Patch applied successfully!")
+}
+
+// parseArgs parses flags given before, between or after the positional
+// arguments in args, and returns the positional arguments.
+func parseArgs(fs *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		fs.Parse(args)
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func byteOrderOpts(bigEndian bool) []gogt.Option {
+	if bigEndian {
+		return []gogt.Option{gogt.WithByteOrder(binary.BigEndian)}
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package gogt
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+func decodeItemsData(data []byte, opts options) (*ItemsData, error) {
+	d := newDecoder(bytes.NewReader(data), opts)
+	version, itemCount, err := d.Header()
+	if err != nil {
+		return nil, err
+	}
+	itemsData := &ItemsData{Version: version, ItemCount: itemCount}
+	for d.Next() {
+		itemsData.Items = append(itemsData.Items, d.Item())
+	}
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
+	trailing, err := d.Trailing()
+	if err != nil {
+		return nil, err
+	}
+	if len(trailing) > 0 {
+		itemsData.Trailing = trailing
+	}
+	return itemsData, nil
+}
+
+// A Decoder reads items from an items.dat stream one at a time.
+type Decoder struct {
+	r         *reader
+	version   int
+	itemCount int
+	fields    []field
+	header    bool
+	n         int
+	item      Item
+	err       error
+}
+
+// NewDecoder returns a Decoder that reads from r.
+func NewDecoder(r io.Reader, opts ...Option) *Decoder {
+	return newDecoder(r, newOptions(opts))
+}
+
+func newDecoder(r io.Reader, opts options) *Decoder {
+	return &Decoder{r: &reader{r: bufio.NewReader(r), order: opts.order}}
+}
+
+// Header reads the file header if it has not been read yet and returns the
+// version and the number of items the file declares.
+func (d *Decoder) Header() (version, itemCount int, err error) {
+	if !d.header {
+		d.header = true
+		d.version = int(d.r.u16("Version"))
+		d.itemCount = int(d.r.u32("ItemCount"))
+		if d.r.err != nil {
+			d.err = d.decodeError(-1, -1)
+		} else if d.version > LatestVersion {
+			d.err = &DecodeError{
+				Index:  -1,
+				ItemID: -1,
+				Field:  "Version",
+				Offset: 0,
+				Err:    fmt.Errorf("%w %d (newest supported is %d)", ErrUnsupportedVersion, d.version, LatestVersion),
+			}
+		}
+		d.fields = fieldsFor(d.version)
+	}
+	return d.version, d.itemCount, d.err
+}
+
+// Next decodes the next item, which is then available through Item. It
+// returns false once every declared item has been read or an error occurs;
+// call Err to tell the two apart.
+func (d *Decoder) Next() bool {
+	if _, _, err := d.Header(); err != nil {
+		return false
+	}
+	if d.n >= d.itemCount {
+		return false
+	}
+	d.item = d.decodeItem()
+	if d.r.err != nil {
+		itemID := -1
+		if d.r.field != "ItemID" {
+			itemID = d.item.ItemID
+		}
+		d.err = d.decodeError(d.n, itemID)
+		return false
+	}
+	d.n++
+	return true
+}
+
+// Item returns the item decoded by the most recent call to Next.
+func (d *Decoder) Item() Item {
+	return d.item
+}
+
+// Trailing returns any bytes that follow the last declared item. It must
+// only be called once Next has returned false.
+func (d *Decoder) Trailing() ([]byte, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	trailing, err := io.ReadAll(d.r.r)
+	d.r.pos += int64(len(trailing))
+	return trailing, err
+}
+
+// Err returns the first error encountered by the Decoder. Errors caused by
+// the data itself are of type *DecodeError.
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+func (d *Decoder) decodeError(index, itemID int) error {
+	return &DecodeError{
+		Index:  index,
+		ItemID: itemID,
+		Field:  d.r.field,
+		Offset: d.r.at,
+		Err:    d.r.err,
+	}
+}
+
+func (d *Decoder) decodeItem() Item {
+	var item Item
+	d.r.decodeFields(&item, d.fields)
+	return item
+}
+
+func (r *reader) decodeFields(item *Item, fields []field) {
+	rv := reflect.ValueOf(item).Elem()
+	for _, f := range fields {
+		v := rv.Field(f.index)
+		switch f.kind {
+		case kindUint:
+			if v.Kind() == reflect.Array {
+				for i := 0; i < v.Len(); i++ {
+					setUint(v.Index(i), r.uint(f.name, f.width))
+				}
+			} else {
+				setUint(v, r.uint(f.name, f.width))
+			}
+		case kindString:
+			v.SetString(r.str(f.name, f.encrypted, item.ItemID))
+		case kindBytes:
+			reflect.Copy(v, reflect.ValueOf(r.bytes(f.name, f.width)))
+		case kindColor:
+			b := r.bytes(f.name, 4)
+			v.Set(reflect.ValueOf(Color{A: int(b[0]), R: int(b[1]), G: int(b[2]), B: int(b[3])}))
+		case kindBreakHits:
+			v.Set(reflect.ValueOf(RawBreakHits(r.u8(f.name))))
+		}
+		if r.err != nil {
+			return
+		}
+	}
+}
+
+func setUint(v reflect.Value, n uint32) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(n))
+	default:
+		v.SetUint(uint64(n))
+	}
+}
+
+// reader reads items.dat fields from a stream. The first error is kept in
+// err, together with the field and offset it occurred at, and turns every
+// later read into a no-op returning zero values.
+type reader struct {
+	r     *bufio.Reader
+	order ByteOrder
+	pos   int64
+	buf   [4]byte
+	err   error
+	field string
+	at    int64
+}
+
+func (r *reader) read(field string, p []byte) bool {
+	if r.err != nil {
+		return false
+	}
+	n, err := io.ReadFull(r.r, p)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		r.err = err
+		r.field = field
+		r.at = r.pos
+	}
+	r.pos += int64(n)
+	return err == nil
+}
+
+func (r *reader) u8(field string) uint8 {
+	if !r.read(field, r.buf[:1]) {
+		return 0
+	}
+	return r.buf[0]
+}
+
+func (r *reader) u16(field string) uint16 {
+	if !r.read(field, r.buf[:2]) {
+		return 0
+	}
+	return r.order.Uint16(r.buf[:2])
+}
+
+func (r *reader) u32(field string) uint32 {
+	if !r.read(field, r.buf[:4]) {
+		return 0
+	}
+	return r.order.Uint32(r.buf[:4])
+}
+
+func (r *reader) uint(field string, width int) uint32 {
+	switch width {
+	case 1:
+		return uint32(r.u8(field))
+	case 2:
+		return uint32(r.u16(field))
+	}
+	return r.u32(field)
+}
+
+func (r *reader) bytes(field string, n int) []byte {
+	p := make([]byte, n)
+	r.read(field, p)
+	return p
+}
+
+func (r *reader) str(field string, usingKey bool, itemID int) string {
+	start := r.pos
+	strLen := int(r.u16(field))
+	if strLen == 0 {
+		return ""
+	}
+	result := r.bytes(field, strLen)
+	if r.err != nil {
+		r.at = start
+		return ""
+	}
+	if usingKey {
+		for i := 0; i < strLen; i++ {
+			// Use modulo to restrict the index to the length of itemsSecretKey
+			keyIndex := (uint32(i) + uint32(itemID)) % uint32(len(itemsSecretKey))
+			result[i] ^= itemsSecretKey[keyIndex]
+		}
+	}
+	return string(result)
+}
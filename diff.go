@@ -0,0 +1,81 @@
+package gogt
+
+import (
+	"reflect"
+	"sort"
+)
+
+// ChangeKind says whether an item was added, removed or changed.
+type ChangeKind string
+
+const (
+	ChangeAdded   ChangeKind = "added"
+	ChangeRemoved ChangeKind = "removed"
+	ChangeChanged ChangeKind = "changed"
+)
+
+// An ItemChange describes how one item differs between two files.
+type ItemChange struct {
+	ItemID int           `json:"item_id"`
+	Name   string        `json:"name"` // name in the newer file, or the older one for removed items
+	Kind   ChangeKind    `json:"kind"`
+	Fields []FieldChange `json:"fields,omitempty"` // set for ChangeChanged only
+}
+
+// A FieldChange is one field that differs between two versions of an item.
+// Old and New hold values of the Item field's type.
+type FieldChange struct {
+	Field string `json:"field"` // JSON name of the field
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+// Diff reports the items added to, removed from and changed between a and
+// b, ordered by item ID. Items are matched by ID. When the files have
+// different versions, only the fields both versions store are compared.
+func Diff(a, b *ItemsData) []ItemChange {
+	fields := fieldsFor(min(a.Version, b.Version))
+	// Diff reads every item anyway, so a fresh index costs little and cannot
+	// be out of date.
+	idxA, idxB := buildIndex(a.Items), buildIndex(b.Items)
+
+	ids := make([]int, 0, len(idxB.byID))
+	for id := range idxA.byID {
+		ids = append(ids, id)
+	}
+	for id := range idxB.byID {
+		if _, ok := idxA.byID[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	var changes []ItemChange
+	for _, id := range ids {
+		i, inA := idxA.byID[id]
+		j, inB := idxB.byID[id]
+		switch {
+		case !inA:
+			changes = append(changes, ItemChange{ItemID: id, Name: b.Items[j].Name, Kind: ChangeAdded})
+		case !inB:
+			changes = append(changes, ItemChange{ItemID: id, Name: a.Items[i].Name, Kind: ChangeRemoved})
+		default:
+			if diffs := diffItem(&a.Items[i], &b.Items[j], fields); diffs != nil {
+				changes = append(changes, ItemChange{ItemID: id, Name: b.Items[j].Name, Kind: ChangeChanged, Fields: diffs})
+			}
+		}
+	}
+	return changes
+}
+
+func diffItem(a, b *Item, fields []field) []FieldChange {
+	va, vb := reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem()
+	var diffs []FieldChange
+	for _, f := range fields {
+		old, cur := va.Field(f.index).Interface(), vb.Field(f.index).Interface()
+		if !reflect.DeepEqual(old, cur) {
+			diffs = append(diffs, FieldChange{Field: f.jsonName, Old: old, New: cur})
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,41 @@
+package gogt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	a := fixture(LatestVersion)
+	b := fixture(LatestVersion)
+	b.Items[1].Name = "Renamed"
+	b.Items[1].Rarity = 5
+	b.Items = b.Items[:3]
+	b.Items = append(b.Items, Item{ItemID: 9, Name: "New"})
+
+	got := Diff(a, b)
+	want := []ItemChange{
+		{ItemID: 1, Name: "Renamed", Kind: ChangeChanged, Fields: []FieldChange{
+			{Field: "name", Old: a.Items[1].Name, New: "Renamed"},
+			{Field: "rarity", Old: 999, New: 5},
+		}},
+		{ItemID: 3, Name: a.Items[3].Name, Kind: ChangeRemoved},
+		{ItemID: 9, Name: "New", Kind: ChangeAdded},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Diff() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestDiffAcrossVersions(t *testing.T) {
+	a := fixture(18)
+	b := fixture(LatestVersion)
+	if got := Diff(a, b); got != nil {
+		t.Fatalf("Diff() = %+v, want no changes in the shared fields", got)
+	}
+	b.Items[0].IntVersion18 = 99
+	got := Diff(a, b)
+	if len(got) != 1 || len(got[0].Fields) != 1 || got[0].Fields[0].Field != "int_version_18" {
+		t.Fatalf("Diff() = %+v, want only int_version_18 on item 0", got)
+	}
+}
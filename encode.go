@@ -0,0 +1,246 @@
+package gogt
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+func encodeItemsData(itemsData *ItemsData, opts options) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Grow(2 + 4 + len(itemsData.Items)*213)
+	e := newEncoder(&buf, itemsData.Version, opts)
+	if err := e.SetItemCount(itemsData.ItemCount); err != nil {
+		return nil, err
+	}
+	for _, item := range itemsData.Items {
+		if err := e.WriteItem(item); err != nil {
+			return nil, err
+		}
+	}
+	if len(itemsData.Trailing) > 0 {
+		if err := e.WriteTrailing(itemsData.Trailing); err != nil {
+			return nil, err
+		}
+	}
+	if err := e.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// An Encoder writes items to an items.dat stream one at a time.
+//
+// The header is written before the first item. If the underlying writer is
+// an io.WriteSeeker the item count is patched into the header by Close;
+// otherwise it must be declared up front with SetItemCount.
+type Encoder struct {
+	w         io.Writer
+	bw        *bufio.Writer
+	order     ByteOrder
+	version   int
+	truncate  bool
+	itemCount int
+	fields    []field
+	declared  bool
+	header    bool
+	headerPos int64
+	n         int
+	buf       []byte
+	err       error
+}
+
+// NewEncoder returns an Encoder that writes a version items.dat file to w.
+func NewEncoder(w io.Writer, version int, opts ...Option) *Encoder {
+	return newEncoder(w, version, newOptions(opts))
+}
+
+func newEncoder(w io.Writer, version int, opts options) *Encoder {
+	return &Encoder{w: w, bw: bufio.NewWriter(w), order: opts.order, version: version, truncate: opts.truncate, fields: fieldsFor(version)}
+}
+
+// SetItemCount declares how many items will be written. It must be called
+// before the first WriteItem, and is required when the underlying writer
+// cannot seek.
+func (e *Encoder) SetItemCount(n int) error {
+	if e.header {
+		return errors.New("item count must be set before the first item is written")
+	}
+	e.itemCount = n
+	e.declared = true
+	return nil
+}
+
+// WriteItem encodes item and writes it to the stream.
+func (e *Encoder) WriteItem(item Item) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	buf, err := e.appendItem(e.buf[:0], item)
+	e.buf = buf
+	if err != nil {
+		return err
+	}
+	if _, err := e.bw.Write(e.buf); err != nil {
+		e.err = err
+		return err
+	}
+	e.n++
+	return nil
+}
+
+// WriteTrailing writes raw bytes after the last item. It is used to carry
+// data the decoder did not understand through a round-trip unchanged.
+func (e *Encoder) WriteTrailing(p []byte) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	if _, err := e.bw.Write(p); err != nil {
+		e.err = err
+		return err
+	}
+	return nil
+}
+
+// Close flushes buffered data and finalises the header item count. It does
+// not close the underlying writer.
+func (e *Encoder) Close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	if err := e.bw.Flush(); err != nil {
+		return err
+	}
+	if e.declared {
+		if e.n != e.itemCount {
+			return fmt.Errorf("wrote %d items but the header declares %d", e.n, e.itemCount)
+		}
+		return nil
+	}
+
+	ws := e.w.(io.WriteSeeker)
+	end, err := ws.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := ws.Seek(e.headerPos+2, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := ws.Write(e.order.AppendUint32(nil, uint32(e.n))); err != nil {
+		return err
+	}
+	_, err = ws.Seek(end, io.SeekStart)
+	return err
+}
+
+func (e *Encoder) writeHeader() error {
+	if e.err != nil || e.header {
+		return e.err
+	}
+	e.header = true
+	if e.version < 0 || e.version > LatestVersion {
+		e.err = fmt.Errorf("%w %d (supported versions are 0-%d)", ErrUnsupportedVersion, e.version, LatestVersion)
+		return e.err
+	}
+	if !e.declared {
+		ws, ok := e.w.(io.WriteSeeker)
+		if !ok {
+			e.err = errors.New("writer cannot seek; call SetItemCount before writing items")
+			return e.err
+		}
+		pos, err := ws.Seek(0, io.SeekCurrent)
+		if err != nil {
+			e.err = err
+			return err
+		}
+		e.headerPos = pos
+	}
+	header := e.order.AppendUint16(nil, uint16(e.version))
+	header = e.order.AppendUint32(header, uint32(e.itemCount))
+	if _, err := e.bw.Write(header); err != nil {
+		e.err = err
+	}
+	return e.err
+}
+
+func (e *Encoder) appendItem(encodedData []byte, item Item) ([]byte, error) {
+	order := e.order
+	rv := reflect.ValueOf(item)
+	for _, f := range e.fields {
+		v := rv.Field(f.index)
+		if !e.truncate {
+			if err := f.checkRange(v); err != nil {
+				return encodedData, &EncodeError{Index: e.n, ItemID: item.ItemID, Field: f.name, Err: err}
+			}
+		}
+		switch f.kind {
+		case kindUint:
+			if v.Kind() == reflect.Array {
+				for i := 0; i < v.Len(); i++ {
+					encodedData = appendUint(encodedData, order, f.width, getUint(v.Index(i)))
+				}
+			} else {
+				encodedData = appendUint(encodedData, order, f.width, getUint(v))
+			}
+		case kindString:
+			str := v.String()
+			if len(str) > maxStringLen {
+				err := fmt.Errorf("%d bytes long; items.dat strings hold at most %d", len(str), maxStringLen)
+				return encodedData, &EncodeError{Index: e.n, ItemID: item.ItemID, Field: f.name, Err: err}
+			}
+			encodedData = append(encodedData, writeString(str, f.encrypted, item.ItemID, order)...)
+		case kindBytes:
+			for i := 0; i < v.Len(); i++ {
+				encodedData = append(encodedData, byte(v.Index(i).Uint()))
+			}
+		case kindColor:
+			c := v.Interface().(Color)
+			encodedData = append(encodedData, byte(c.A), byte(c.R), byte(c.G), byte(c.B))
+		case kindBreakHits:
+			encodedData = append(encodedData, v.Interface().(BreakHits).Raw())
+		}
+	}
+	return encodedData, nil
+}
+
+func appendUint(b []byte, order ByteOrder, width int, n uint64) []byte {
+	switch width {
+	case 1:
+		return append(b, byte(n))
+	case 2:
+		return order.AppendUint16(b, uint16(n))
+	}
+	return order.AppendUint32(b, uint32(n))
+}
+
+func getUint(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(v.Int())
+	}
+	return v.Uint()
+}
+
+// maxStringLen is the longest string a 16-bit length prefix can describe.
+const maxStringLen = 0xFFFF
+
+// writeString returns str with its 16-bit length prefix, XOR-encrypting the
+// contents when usingKey is set.
+func writeString(str string, usingKey bool, itemID int, order ByteOrder) []byte {
+	result := make([]byte, 0, len(str)+2)
+	result = order.AppendUint16(result, uint16(len(str)))
+	for i := 0; i < len(str); i++ {
+		if usingKey {
+			// Same key index as the decoder, which also keeps it in range
+			// for a negative itemID written under Truncate.
+			keyIndex := (uint32(i) + uint32(itemID)) % uint32(len(itemsSecretKey))
+			result = append(result, str[i]^itemsSecretKey[keyIndex])
+		} else {
+			result = append(result, byte(str[i]))
+		}
+	}
+	return result
+}
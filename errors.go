@@ -0,0 +1,42 @@
+package gogt
+
+import "fmt"
+
+// A DecodeError reports where decoding an items.dat file failed.
+type DecodeError struct {
+	Index  int    // index of the item being decoded, or -1 for the header
+	ItemID int    // ID of the item being decoded, or -1 if not yet known
+	Field  string // name of the field being read
+	Offset int64  // byte offset of the field from the start of the stream
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("gogt: header field %s at offset %d: %v", e.Field, e.Offset, e.Err)
+	}
+	if e.ItemID < 0 {
+		return fmt.Sprintf("gogt: item %d field %s at offset %d: %v", e.Index, e.Field, e.Offset, e.Err)
+	}
+	return fmt.Sprintf("gogt: item %d (id %d) field %s at offset %d: %v", e.Index, e.ItemID, e.Field, e.Offset, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// An EncodeError reports an item field whose value cannot be written.
+type EncodeError struct {
+	Index  int    // index of the item being encoded
+	ItemID int    // ID of the item being encoded
+	Field  string // name of the field
+	Err    error
+}
+
+func (e *EncodeError) Error() string {
+	return fmt.Sprintf("gogt: item %d (id %d) field %s: %v", e.Index, e.ItemID, e.Field, e.Err)
+}
+
+func (e *EncodeError) Unwrap() error {
+	return e.Err
+}
@@ -1,5 +1,29 @@
+// Package gogt reads and writes Growtopia items.dat files.
 package gogt
 
-import (
-	
-)
\ No newline at end of file
+import "errors"
+
+const (
+	itemsSecretKey = "PBG892FXX982ABC*"
+)
+
+// LatestVersion is the newest items.dat version this package can read and
+// write.
+const LatestVersion = 22
+
+// ErrUnsupportedVersion is returned for files whose header version is newer
+// than LatestVersion, whose item layout is unknown, and when encoding a
+// negative version, which the header cannot store.
+var ErrUnsupportedVersion = errors.New("unsupported items.dat version")
+
+// Decode parses the contents of an items.dat file. Regions the decoder does
+// not interpret are kept as raw bytes so that nothing is lost on re-encode.
+func Decode(data []byte, opts ...Option) (*ItemsData, error) {
+	return decodeItemsData(data, newOptions(opts))
+}
+
+// Encode serialises itemsData into the items.dat binary format. Encoding the
+// result of Decode reproduces the original file byte for byte.
+func Encode(itemsData *ItemsData, opts ...Option) ([]byte, error) {
+	return encodeItemsData(itemsData, newOptions(opts))
+}
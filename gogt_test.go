@@ -0,0 +1,432 @@
+package gogt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fixture builds a small synthetic items.dat for version with every field
+// the version carries set to a non-zero value.
+func fixture(version int) *ItemsData {
+	itemsData := &ItemsData{Version: version}
+	for id := 0; id < 4; id++ {
+		seq := func(n, start int) []byte {
+			b := make([]byte, n)
+			for i := range b {
+				b[i] = byte(start + i)
+			}
+			return b
+		}
+		item := Item{
+			ItemID:             id,
+			EditableType:       EditableType(1 + id),
+			ItemCategory:       2,
+			ActionType:         ActionType(17 + id%2*2),
+			HitSoundType:       3,
+			Name:               "Fixture Item " + strings.Repeat("x", id),
+			Texture:            "tiles_page1.rttex",
+			TextureHash:        0x12345678,
+			ItemKind:           4,
+			Val1:               0x01020304,
+			TextureX:           5 + id,
+			TextureY:           6,
+			SpreadType:         2,
+			IsStripeyWallpaper: 1,
+			CollisionType:      1,
+			BreakHits:          RawBreakHits(184),
+			DropChance:         3600,
+			ClothingType:       7,
+			Rarity:             999,
+			MaxAmount:          200,
+			ExtraFile:          "audio/punch.wav",
+			ExtraFileHash:      0x0BADF00D,
+			AudioVolume:        100,
+			PetName:            "Pet",
+			PetPrefix:          "Pre",
+			PetSuffix:          "Suf",
+			PetAbility:         "Fly",
+			SeedBase:           1,
+			SeedOverlay:        2,
+			TreeBase:           3,
+			TreeLeaves:         4,
+			SeedColor:          Color{A: 255, R: 10, G: 20, B: 30},
+			SeedOverlayColor:   Color{A: 128, R: 40, G: 50, B: 60},
+			Ingredients:        [2]uint16{uint16(id), uint16(id + 1000)},
+			GrowTime:           31,
+			Val2:               0x0102,
+			IsRayman:           1,
+			ExtraOptions:       "opts",
+			Texture2:           "tex2.rttex",
+			ExtraOptions2:      "opts2",
+			DataPosition80:     Bytes80(seq(80, id)),
+		}
+		if id%2 == 0 {
+			item.BreakHits = RawBreakHits(6)
+		}
+		if version >= 11 {
+			item.PunchOptions = "punch"
+		}
+		if version >= 12 {
+			item.DataVersion12 = Bytes13(seq(13, 100))
+		}
+		if version >= 13 {
+			item.IntVersion13 = 13
+		}
+		if version >= 14 {
+			item.IntVersion14 = 14
+		}
+		if version >= 15 {
+			item.DataVersion15 = Bytes25(seq(25, 200))
+			item.StrVersion15 = "v15"
+		}
+		if version >= 16 {
+			item.StrVersion16 = "v16"
+		}
+		if version >= 17 {
+			item.IntVersion17 = 17
+		}
+		if version >= 18 {
+			item.IntVersion18 = 18
+		}
+		if version >= 19 {
+			item.DataVersion19 = Bytes9(seq(9, 19))
+		}
+		if version >= 21 {
+			item.IntVersion21 = 21
+		}
+		if version >= 22 {
+			item.StrVersion22 = "v22"
+		}
+		itemsData.Items = append(itemsData.Items, item)
+	}
+	itemsData.ItemCount = len(itemsData.Items)
+	return itemsData
+}
+
+func mustEncode(t testing.TB, itemsData *ItemsData, opts ...Option) []byte {
+	t.Helper()
+	data, err := Encode(itemsData, opts...)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	return data
+}
+
+func TestRoundTripAllVersions(t *testing.T) {
+	for version := 1; version <= LatestVersion; version++ {
+		want := fixture(version)
+		data := mustEncode(t, want)
+
+		got, err := Decode(data)
+		if err != nil {
+			t.Fatalf("version %d: Decode: %v", version, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("version %d: decoded items differ from fixture\ngot  %+v\nwant %+v", version, got.Items[0], want.Items[0])
+		}
+		if again := mustEncode(t, got); !bytes.Equal(again, data) {
+			t.Fatalf("version %d: re-encoding changed the output", version)
+		}
+	}
+}
+
+func TestRoundTripTrailingBytes(t *testing.T) {
+	data := append(mustEncode(t, fixture(LatestVersion)), 0xDE, 0xAD)
+	itemsData, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(itemsData.Trailing, []byte{0xDE, 0xAD}) {
+		t.Fatalf("Trailing = %X, want DEAD", itemsData.Trailing)
+	}
+	if got := mustEncode(t, itemsData); !bytes.Equal(got, data) {
+		t.Fatal("trailing bytes were not preserved")
+	}
+}
+
+func TestUnsupportedVersion(t *testing.T) {
+	data := mustEncode(t, fixture(LatestVersion))
+	binary.LittleEndian.PutUint16(data, LatestVersion+1)
+	_, err := Decode(data)
+	if !errors.Is(err, ErrUnsupportedVersion) {
+		t.Fatalf("Decode error = %v, want ErrUnsupportedVersion", err)
+	}
+
+	for _, version := range []int{-1, LatestVersion + 1} {
+		itemsData := fixture(LatestVersion)
+		itemsData.Version = version
+		if _, err := Encode(itemsData); !errors.Is(err, ErrUnsupportedVersion) {
+			t.Fatalf("Encode version %d error = %v, want ErrUnsupportedVersion", version, err)
+		}
+	}
+}
+
+func TestEncodeLayout(t *testing.T) {
+	itemsData := fixture(LatestVersion)
+	data := mustEncode(t, itemsData)
+
+	if got := binary.LittleEndian.Uint16(data); got != LatestVersion {
+		t.Errorf("version = %d, want %d", got, LatestVersion)
+	}
+	if got := binary.LittleEndian.Uint32(data[2:]); got != 4 {
+		t.Errorf("item count = %d, want 4", got)
+	}
+	if got := binary.LittleEndian.Uint32(data[6:]); got != 0 {
+		t.Errorf("first item id = %d, want 0", got)
+	}
+	// ItemID, four flag bytes, then the name with a single length prefix.
+	name := itemsData.Items[0].Name
+	if got := binary.LittleEndian.Uint16(data[14:]); int(got) != len(name) {
+		t.Errorf("name length = %d, want %d", got, len(name))
+	}
+	texture := itemsData.Items[0].Texture
+	off := 16 + len(name)
+	if got := binary.LittleEndian.Uint16(data[off:]); int(got) != len(texture) {
+		t.Errorf("texture length = %d, want %d", got, len(texture))
+	}
+	if got := string(data[off+2 : off+2+len(texture)]); got != texture {
+		t.Errorf("texture = %q, want %q", got, texture)
+	}
+}
+
+func TestByteOrderOption(t *testing.T) {
+	itemsData := fixture(LatestVersion)
+	data := mustEncode(t, itemsData, WithByteOrder(binary.BigEndian))
+	if got := binary.BigEndian.Uint16(data); got != LatestVersion {
+		t.Fatalf("version = %d, want %d", got, LatestVersion)
+	}
+	got, err := Decode(data, WithByteOrder(binary.BigEndian))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, itemsData) {
+		t.Fatal("big-endian round-trip differs")
+	}
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	data := mustEncode(t, fixture(LatestVersion))
+	for n := 0; n < len(data); n++ {
+		_, err := Decode(data[:n])
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Fatalf("Decode(data[:%d]) error = %v, want *DecodeError", n, err)
+		}
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Fatalf("Decode(data[:%d]) error = %v, want io.ErrUnexpectedEOF", n, err)
+		}
+		if decodeErr.Offset > int64(n) {
+			t.Fatalf("Decode(data[:%d]) offset %d beyond input", n, decodeErr.Offset)
+		}
+	}
+}
+
+func TestDecodeErrorFields(t *testing.T) {
+	data := mustEncode(t, fixture(LatestVersion))
+	// Cut inside the texture string of the second item.
+	first, _ := itemSizes(t, data)
+	cut := 6 + first + 4 + 4 + 2 + len(fixture(LatestVersion).Items[1].Name) + 3
+	_, err := Decode(data[:cut])
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("error = %v, want *DecodeError", err)
+	}
+	if decodeErr.Index != 1 || decodeErr.ItemID != 1 || decodeErr.Field != "Texture" {
+		t.Fatalf("error = %+v, want item 1, id 1, field Texture", decodeErr)
+	}
+	wantOffset := int64(6 + first + 4 + 4 + 2 + len(fixture(LatestVersion).Items[1].Name))
+	if decodeErr.Offset != wantOffset {
+		t.Fatalf("offset = %d, want %d", decodeErr.Offset, wantOffset)
+	}
+}
+
+// itemSizes returns the encoded size of the first item and the offset the
+// decoder reached after it.
+func itemSizes(t *testing.T, data []byte) (int, int64) {
+	t.Helper()
+	d := NewDecoder(bytes.NewReader(data))
+	if !d.Next() {
+		t.Fatal(d.Err())
+	}
+	return int(d.r.pos) - 6, d.r.pos
+}
+
+func TestDecoderStream(t *testing.T) {
+	itemsData := fixture(LatestVersion)
+	d := NewDecoder(bytes.NewReader(mustEncode(t, itemsData)))
+	version, itemCount, err := d.Header()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != LatestVersion || itemCount != len(itemsData.Items) {
+		t.Fatalf("Header() = %d, %d", version, itemCount)
+	}
+	var n int
+	for d.Next() {
+		if !reflect.DeepEqual(d.Item(), itemsData.Items[n]) {
+			t.Fatalf("item %d differs", n)
+		}
+		n++
+	}
+	if err := d.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != len(itemsData.Items) {
+		t.Fatalf("read %d items, want %d", n, len(itemsData.Items))
+	}
+}
+
+type seekBuffer struct {
+	buf []byte
+	pos int
+}
+
+func (s *seekBuffer) Write(p []byte) (int, error) {
+	if end := s.pos + len(p); end > len(s.buf) {
+		s.buf = append(s.buf, make([]byte, end-len(s.buf))...)
+	}
+	copy(s.buf[s.pos:], p)
+	s.pos += len(p)
+	return len(p), nil
+}
+
+func (s *seekBuffer) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		s.pos = int(offset)
+	case io.SeekCurrent:
+		s.pos += int(offset)
+	case io.SeekEnd:
+		s.pos = len(s.buf) + int(offset)
+	}
+	return int64(s.pos), nil
+}
+
+func TestEncoderPatchesItemCount(t *testing.T) {
+	itemsData := fixture(LatestVersion)
+	var out seekBuffer
+	e := NewEncoder(&out, LatestVersion)
+	for _, item := range itemsData.Items {
+		if err := e.WriteItem(item); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if want := mustEncode(t, itemsData); !bytes.Equal(out.buf, want) {
+		t.Fatal("streamed output differs from Encode")
+	}
+}
+
+func TestEncoderNeedsItemCount(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewEncoder(&buf, LatestVersion)
+	if err := e.WriteItem(fixture(LatestVersion).Items[0]); err == nil {
+		t.Fatal("WriteItem on a non-seekable writer without SetItemCount succeeded")
+	}
+
+	e = NewEncoder(&buf, LatestVersion)
+	e.SetItemCount(2)
+	e.WriteItem(fixture(LatestVersion).Items[0])
+	if err := e.Close(); err == nil {
+		t.Fatal("Close with fewer items than declared succeeded")
+	}
+}
+
+func TestEncodeStringTooLong(t *testing.T) {
+	itemsData := fixture(LatestVersion)
+	itemsData.Items[2].Texture = strings.Repeat("a", maxStringLen+1)
+	_, err := Encode(itemsData)
+	if err == nil || !strings.Contains(err.Error(), "Texture") {
+		t.Fatalf("Encode error = %v, want an error naming Texture", err)
+	}
+}
+
+func TestEncodeRange(t *testing.T) {
+	itemsData := fixture(LatestVersion)
+	itemsData.Items[1].MaxAmount = 300
+	_, err := Encode(itemsData)
+	var encErr *EncodeError
+	if !errors.As(err, &encErr) || encErr.Index != 1 || encErr.ItemID != 1 || encErr.Field != "MaxAmount" {
+		t.Fatalf("Encode error = %v, want an *EncodeError for item 1 MaxAmount", err)
+	}
+
+	data := mustEncode(t, itemsData, Truncate())
+	got, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Items[1].MaxAmount != 300&0xFF {
+		t.Fatalf("truncated MaxAmount = %d, want %d", got.Items[1].MaxAmount, 300&0xFF)
+	}
+
+	itemsData = fixture(LatestVersion)
+	itemsData.Items[0].SeedColor.G = -1
+	if _, err := Encode(itemsData); !errors.As(err, &encErr) || encErr.Field != "SeedColor" {
+		t.Fatalf("Encode error = %v, want an *EncodeError for SeedColor", err)
+	}
+}
+
+func TestEncodeTruncateNegativeID(t *testing.T) {
+	itemsData := &ItemsData{Version: LatestVersion, ItemCount: 1, Items: []Item{{ItemID: -1, Name: "abc"}}}
+	var encErr *EncodeError
+	if _, err := Encode(itemsData); !errors.As(err, &encErr) || encErr.Field != "ItemID" {
+		t.Fatalf("Encode error = %v, want an *EncodeError for ItemID", err)
+	}
+
+	got, err := Decode(mustEncode(t, itemsData, Truncate()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item := got.Items[0]; uint32(item.ItemID) != 0xFFFFFFFF || item.Name != "abc" {
+		t.Fatalf("decoded item %d %q, want id 0xFFFFFFFF named abc", item.ItemID, item.Name)
+	}
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	want := fixture(LatestVersion)
+	want.Trailing = HexBytes{1, 2}
+	var buf bytes.Buffer
+	if err := WriteText(&buf, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadText(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatal("text round-trip differs")
+	}
+}
+
+func FuzzDecode(f *testing.F) {
+	for version := 1; version <= LatestVersion; version++ {
+		f.Add(mustEncode(f, fixture(version)))
+	}
+	f.Add([]byte{})
+	f.Add([]byte{18, 0, 255, 255, 255, 255})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		itemsData, err := Decode(data)
+		if err != nil {
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("error %v is not a *DecodeError", err)
+			}
+			return
+		}
+		encoded, err := Encode(itemsData)
+		if err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+		if !bytes.Equal(encoded, data) {
+			t.Fatalf("Encode(Decode(x)) != x")
+		}
+	})
+}
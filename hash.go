@@ -0,0 +1,13 @@
+package gogt
+
+// FileHash returns the hash the game client computes over items.dat and its
+// other cached files. Servers send it so the client can tell whether its copy
+// is up to date. It must be computed over the encoded file, not a decoded
+// form of it.
+func FileHash(data []byte) uint32 {
+	acc := uint32(0x55555555)
+	for _, b := range data {
+		acc = (acc >> 27) + (acc << 5) + uint32(b)
+	}
+	return acc
+}
@@ -0,0 +1,19 @@
+package gogt
+
+import "testing"
+
+func TestFileHash(t *testing.T) {
+	tests := []struct {
+		data string
+		want uint32
+	}{
+		{"", 0x55555555},
+		{"a", 0xAAAAAB0B},
+		{"\x00", 0xAAAAAAAA},
+	}
+	for _, tt := range tests {
+		if got := FileHash([]byte(tt.data)); got != tt.want {
+			t.Errorf("FileHash(%q) = %#x, want %#x", tt.data, got, tt.want)
+		}
+	}
+}
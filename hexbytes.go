@@ -0,0 +1,75 @@
+package gogt
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Bytes9, Bytes13, Bytes25 and Bytes80 hold fixed-size item regions whose
+// meaning is not decoded. In JSON and text they are written as
+// space-separated hex pairs; parsing rejects bad digits and wrong lengths.
+type (
+	Bytes9  [9]byte
+	Bytes13 [13]byte
+	Bytes25 [25]byte
+	Bytes80 [80]byte
+)
+
+func (b Bytes9) MarshalText() ([]byte, error)      { return marshalHex(b[:]), nil }
+func (b *Bytes9) UnmarshalText(text []byte) error  { return unmarshalHexFixed(b[:], text) }
+func (b Bytes13) MarshalText() ([]byte, error)     { return marshalHex(b[:]), nil }
+func (b *Bytes13) UnmarshalText(text []byte) error { return unmarshalHexFixed(b[:], text) }
+func (b Bytes25) MarshalText() ([]byte, error)     { return marshalHex(b[:]), nil }
+func (b *Bytes25) UnmarshalText(text []byte) error { return unmarshalHexFixed(b[:], text) }
+func (b Bytes80) MarshalText() ([]byte, error)     { return marshalHex(b[:]), nil }
+func (b *Bytes80) UnmarshalText(text []byte) error { return unmarshalHexFixed(b[:], text) }
+
+// HexBytes is a variable-length byte string written as hex pairs.
+type HexBytes []byte
+
+func (b HexBytes) MarshalText() ([]byte, error) {
+	return marshalHex(b), nil
+}
+
+func (b *HexBytes) UnmarshalText(text []byte) error {
+	p, err := parseHex(text)
+	if err != nil {
+		return err
+	}
+	*b = p
+	return nil
+}
+
+func marshalHex(b []byte) []byte {
+	out := make([]byte, 0, len(b)*3)
+	for i, c := range b {
+		if i > 0 {
+			out = append(out, ' ')
+		}
+		out = append(out, fmt.Sprintf("%02X", c)...)
+	}
+	return out
+}
+
+// parseHex accepts hex pairs with or without whitespace between them.
+func parseHex(text []byte) ([]byte, error) {
+	digits := strings.Join(strings.Fields(string(text)), "")
+	p, err := hex.DecodeString(digits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex %q: %w", text, err)
+	}
+	return p, nil
+}
+
+func unmarshalHexFixed(dst []byte, text []byte) error {
+	p, err := parseHex(text)
+	if err != nil {
+		return err
+	}
+	if len(p) != len(dst) {
+		return fmt.Errorf("got %d bytes of hex, want %d", len(p), len(dst))
+	}
+	copy(dst, p)
+	return nil
+}
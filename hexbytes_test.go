@@ -0,0 +1,50 @@
+package gogt
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestBytesText(t *testing.T) {
+	var b Bytes13
+	for _, text := range []string{
+		"00 01 02 03 04 05 06 07 08 09 0A 0B 0C",
+		"000102030405060708090a0b0c",
+	} {
+		if err := b.UnmarshalText([]byte(text)); err != nil {
+			t.Fatalf("UnmarshalText(%q): %v", text, err)
+		}
+		if b[12] != 0x0C {
+			t.Fatalf("UnmarshalText(%q) = %v", text, b)
+		}
+	}
+	out, _ := b.MarshalText()
+	if string(out) != "00 01 02 03 04 05 06 07 08 09 0A 0B 0C" {
+		t.Fatalf("MarshalText() = %q", out)
+	}
+}
+
+func TestBytesRejectsBadInput(t *testing.T) {
+	tests := map[string]string{
+		"00 01 02": "want 13",
+		"00 01 02 03 04 05 06 07 08 09 0A 0B 0C 0D": "want 13",
+		"00 01 02 03 04 05 06 07 08 09 0A 0B ZZ":    "invalid hex",
+		"0 01 02 03 04 05 06 07 08 09 0A 0B 0C":     "invalid hex",
+	}
+	for text, want := range tests {
+		var b Bytes13
+		err := b.UnmarshalText([]byte(text))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("UnmarshalText(%q) error = %v, want %q", text, err, want)
+		}
+	}
+}
+
+func TestItemJSONRejectsShortData(t *testing.T) {
+	var item Item
+	err := json.Unmarshal([]byte(`{"data_version_12":"00 01"}`), &item)
+	if err == nil {
+		t.Fatal("short data_version_12 decoded without error")
+	}
+}
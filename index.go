@@ -0,0 +1,224 @@
+package gogt
+
+import (
+	"sort"
+	"strings"
+)
+
+// Match selects how ByName compares item names.
+type Match int
+
+const (
+	// MatchExact matches names exactly.
+	MatchExact Match = iota
+	// MatchFold matches names ignoring case.
+	MatchFold
+	// MatchPrefix matches names starting with the query, ignoring case.
+	MatchPrefix
+	// MatchFuzzy matches names containing the letters of the query in
+	// order, ignoring case. Closer matches are returned first.
+	MatchFuzzy
+)
+
+type itemIndex struct {
+	first    *Item // &Items[0] when built, to notice a replaced slice
+	n        int
+	lastID   int    // ID and name of the last item when built, to notice
+	lastName string // removals followed by appends that restore the length
+	byID     map[int]int
+	byName   map[string][]int
+	names    []string // name per item, to notice renames
+	folded   []string // lower-cased name per item
+	sorted   []int    // item positions ordered by folded name
+}
+
+// ByID returns the item with the given ID.
+func (itemsData *ItemsData) ByID(id int) (*Item, bool) {
+	idx := itemsData.lookupIndex()
+	i, ok := idx.byID[id]
+	if ok && itemsData.Items[i].ItemID != id {
+		idx = itemsData.rebuildIndex()
+		i, ok = idx.byID[id]
+	}
+	if !ok {
+		return nil, false
+	}
+	return &itemsData.Items[i], true
+}
+
+// ByName returns the items whose name matches name under match, best
+// matches first and ties in ID order.
+func (itemsData *ItemsData) ByName(name string, match Match) []*Item {
+	idx := itemsData.lookupIndex()
+	found := idx.find(name, match)
+	for _, pos := range found {
+		if itemsData.Items[pos].Name != idx.names[pos] {
+			idx = itemsData.rebuildIndex()
+			found = idx.find(name, match)
+			break
+		}
+	}
+	items := make([]*Item, len(found))
+	for i, pos := range found {
+		items[i] = &itemsData.Items[pos]
+	}
+	return items
+}
+
+// find returns the positions of the items whose name matches name under
+// match.
+func (idx *itemIndex) find(name string, match Match) []int {
+	var found []int
+	switch match {
+	case MatchExact:
+		found = idx.byName[name]
+	case MatchFold:
+		found = idx.prefix(strings.ToLower(name), true)
+	case MatchPrefix:
+		found = idx.prefix(strings.ToLower(name), false)
+	case MatchFuzzy:
+		found = idx.fuzzy(strings.ToLower(name))
+	}
+	return found
+}
+
+// Reindex rebuilds the lookup index. ByID and ByName notice items being
+// added or removed, and rebuild the index rather than return an item whose
+// ID or name no longer matches. An item whose ID or name was edited may
+// still not be found under its new value until Reindex is called.
+func (itemsData *ItemsData) Reindex() {
+	itemsData.mu.Lock()
+	itemsData.index = nil
+	itemsData.mu.Unlock()
+}
+
+func (itemsData *ItemsData) lookupIndex() *itemIndex {
+	itemsData.mu.Lock()
+	defer itemsData.mu.Unlock()
+	idx := itemsData.index
+	if idx == nil || idx.n != len(itemsData.Items) || (idx.n > 0 && !idx.current(itemsData.Items)) {
+		idx = buildIndex(itemsData.Items)
+		itemsData.index = idx
+	}
+	return idx
+}
+
+// rebuildIndex discards the index after a lookup found it out of date.
+func (itemsData *ItemsData) rebuildIndex() *itemIndex {
+	itemsData.mu.Lock()
+	defer itemsData.mu.Unlock()
+	itemsData.index = buildIndex(itemsData.Items)
+	return itemsData.index
+}
+
+// current reports whether items, of the length idx was built for, still
+// look like the slice it was built from.
+func (idx *itemIndex) current(items []Item) bool {
+	last := &items[len(items)-1]
+	return idx.first == &items[0] && last.ItemID == idx.lastID && last.Name == idx.lastName
+}
+
+func buildIndex(items []Item) *itemIndex {
+	idx := &itemIndex{
+		n:      len(items),
+		byID:   make(map[int]int, len(items)),
+		byName: make(map[string][]int),
+		names:  make([]string, len(items)),
+		folded: make([]string, len(items)),
+		sorted: make([]int, len(items)),
+	}
+	if len(items) > 0 {
+		idx.first = &items[0]
+		idx.lastID = items[len(items)-1].ItemID
+		idx.lastName = items[len(items)-1].Name
+	}
+	for i := range items {
+		if _, dup := idx.byID[items[i].ItemID]; !dup {
+			idx.byID[items[i].ItemID] = i
+		}
+		idx.byName[items[i].Name] = append(idx.byName[items[i].Name], i)
+		idx.names[i] = items[i].Name
+		idx.folded[i] = strings.ToLower(items[i].Name)
+		idx.sorted[i] = i
+	}
+	sort.SliceStable(idx.sorted, func(a, b int) bool {
+		return idx.folded[idx.sorted[a]] < idx.folded[idx.sorted[b]]
+	})
+	return idx
+}
+
+// prefix returns the positions of names starting with query, or equal to
+// it when whole is set, in ID order with exact matches first.
+func (idx *itemIndex) prefix(query string, whole bool) []int {
+	start := sort.Search(len(idx.sorted), func(i int) bool {
+		return idx.folded[idx.sorted[i]] >= query
+	})
+	var found []int
+	for _, pos := range idx.sorted[start:] {
+		name := idx.folded[pos]
+		if !strings.HasPrefix(name, query) || (whole && name != query) {
+			break
+		}
+		found = append(found, pos)
+	}
+	sort.SliceStable(found, func(a, b int) bool {
+		la, lb := len(idx.folded[found[a]]), len(idx.folded[found[b]])
+		if la != lb {
+			return la < lb
+		}
+		return found[a] < found[b]
+	})
+	return found
+}
+
+// fuzzy returns the positions of names containing the runes of query in
+// order, ranked by how tightly they match.
+func (idx *itemIndex) fuzzy(query string) []int {
+	type scored struct {
+		pos, score int
+	}
+	var matches []scored
+	for pos, name := range idx.folded {
+		if score, ok := fuzzyScore(name, query); ok {
+			matches = append(matches, scored{pos, score})
+		}
+	}
+	sort.SliceStable(matches, func(a, b int) bool {
+		return matches[a].score < matches[b].score
+	})
+	found := make([]int, len(matches))
+	for i, m := range matches {
+		found[i] = m.pos
+	}
+	return found
+}
+
+// fuzzyScore reports whether query is a subsequence of name and, if so, a
+// score where lower is better: exact matches beat prefixes, prefixes beat
+// substrings, and looser subsequences rank by how spread out they are.
+func fuzzyScore(name, query string) (int, bool) {
+	switch {
+	case name == query:
+		return 0, true
+	case strings.HasPrefix(name, query):
+		return 1 + len(name) - len(query), true
+	case strings.Contains(name, query):
+		return 1000 + len(name) - len(query), true
+	}
+	gaps, last := 0, -1
+	rest := name
+	offset := 0
+	for _, r := range query {
+		i := strings.IndexRune(rest, r)
+		if i < 0 {
+			return 0, false
+		}
+		if last >= 0 {
+			gaps += offset + i - last - 1
+		}
+		last = offset + i
+		offset += i + len(string(r))
+		rest = rest[i+len(string(r)):]
+	}
+	return 2000 + gaps*100 + len(name), true
+}
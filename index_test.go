@@ -0,0 +1,119 @@
+package gogt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func lookupFixture() *ItemsData {
+	names := []string{"Blank", "Dirt", "Dirt Seed", "Lava", "Lava Seed", "Dirt Block", "dirt", "Lava Lamp"}
+	itemsData := &ItemsData{}
+	for id, name := range names {
+		itemsData.Items = append(itemsData.Items, Item{ItemID: id, Name: name})
+	}
+	itemsData.ItemCount = len(itemsData.Items)
+	return itemsData
+}
+
+func ids(items []*Item) []int {
+	out := []int{}
+	for _, item := range items {
+		out = append(out, item.ItemID)
+	}
+	return out
+}
+
+func TestByID(t *testing.T) {
+	itemsData := lookupFixture()
+	item, ok := itemsData.ByID(4)
+	if !ok || item.Name != "Lava Seed" {
+		t.Fatalf("ByID(4) = %v, %v", item, ok)
+	}
+	if _, ok := itemsData.ByID(100); ok {
+		t.Fatal("ByID(100) found an item")
+	}
+	if item != &itemsData.Items[4] {
+		t.Fatal("ByID does not return a pointer into Items")
+	}
+}
+
+func TestByName(t *testing.T) {
+	itemsData := lookupFixture()
+	tests := []struct {
+		name  string
+		match Match
+		want  []int
+	}{
+		{"Dirt", MatchExact, []int{1}},
+		{"DIRT", MatchExact, []int{}},
+		{"DIRT", MatchFold, []int{1, 6}},
+		{"dirt", MatchPrefix, []int{1, 6, 2, 5}},
+		{"lava s", MatchPrefix, []int{4}},
+		{"lvsd", MatchFuzzy, []int{4}},
+		{"lava", MatchFuzzy, []int{3, 4, 7}},
+		{"seed", MatchFuzzy, []int{2, 4}},
+		{"zzz", MatchFuzzy, []int{}},
+	}
+	for _, tt := range tests {
+		if got := ids(itemsData.ByName(tt.name, tt.match)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ByName(%q, %d) = %v, want %v", tt.name, tt.match, got, tt.want)
+		}
+	}
+}
+
+func TestIndexFollowsChanges(t *testing.T) {
+	itemsData := lookupFixture()
+	itemsData.ByID(0)
+
+	itemsData.Items = append(itemsData.Items, Item{ItemID: 8, Name: "Rock"})
+	if item, ok := itemsData.ByID(8); !ok || item.Name != "Rock" {
+		t.Fatal("index did not pick up an appended item")
+	}
+
+	itemsData.Items[8].Name = "Boulder"
+	itemsData.Reindex()
+	if got := ids(itemsData.ByName("boulder", MatchFold)); !reflect.DeepEqual(got, []int{8}) {
+		t.Fatalf("after Reindex, ByName(boulder) = %v", got)
+	}
+}
+
+func TestIndexRemoveThenAppend(t *testing.T) {
+	itemsData := lookupFixture()
+	itemsData.ByID(0)
+
+	// Same length and backing array as before, with items shifted down.
+	itemsData.Items = append(itemsData.Items[:1], itemsData.Items[2:]...)
+	itemsData.Items = append(itemsData.Items, Item{ItemID: 9, Name: "Rock"})
+
+	if item, ok := itemsData.ByID(2); !ok || item.ItemID != 2 {
+		t.Fatalf("ByID(2) = %+v, %v", item, ok)
+	}
+	if item, ok := itemsData.ByID(9); !ok || item.Name != "Rock" {
+		t.Fatalf("ByID(9) = %+v, %v", item, ok)
+	}
+	if _, ok := itemsData.ByID(1); ok {
+		t.Fatal("ByID(1) found the removed item")
+	}
+	if got := ids(itemsData.ByName("rock", MatchFold)); !reflect.DeepEqual(got, []int{9}) {
+		t.Fatalf("ByName(rock) = %v", got)
+	}
+}
+
+func TestIndexDropsStaleHits(t *testing.T) {
+	itemsData := lookupFixture()
+	itemsData.ByID(0)
+
+	// Swap two items in place; the last item stays the same.
+	itemsData.Items[1], itemsData.Items[2] = itemsData.Items[2], itemsData.Items[1]
+	for _, id := range []int{1, 2} {
+		if item, ok := itemsData.ByID(id); !ok || item.ItemID != id {
+			t.Fatalf("ByID(%d) = %+v, %v", id, item, ok)
+		}
+	}
+	name := itemsData.Items[2].Name
+	for _, item := range itemsData.ByName(name, MatchExact) {
+		if item.Name != name {
+			t.Fatalf("ByName(%q) returned %q", name, item.Name)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package gogt
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+type Item struct {
+	ItemID             int           `json:"item_id"`
+	EditableType       EditableType  `json:"editable_type"`
+	ItemCategory       ItemCategory  `json:"item_category"`
+	ActionType         ActionType    `json:"action_type"`
+	HitSoundType       HitSoundType  `json:"hit_sound_type"`
+	Name               string        `json:"name"`
+	Texture            string        `json:"texture"`
+	TextureHash        int           `json:"texture_hash"`
+	ItemKind           ItemKind      `json:"item_kind"`
+	Val1               int           `json:"val1"`
+	TextureX           int           `json:"texture_x"`
+	TextureY           int           `json:"texture_y"`
+	SpreadType         int           `json:"spread_type"`
+	IsStripeyWallpaper int           `json:"is_stripey_wallpaper"`
+	CollisionType      CollisionType `json:"collision_type"`
+	BreakHits          BreakHits     `json:"break_hits"`
+	DropChance         int           `json:"drop_chance"`
+	ClothingType       ClothingType  `json:"clothing_type"`
+	Rarity             int           `json:"rarity"`
+	MaxAmount          int           `json:"max_amount"`
+	ExtraFile          string        `json:"extra_file"`
+	ExtraFileHash      int           `json:"extra_file_hash"`
+	AudioVolume        int           `json:"audio_volume"`
+	PetName            string        `json:"pet_name"`
+	PetPrefix          string        `json:"pet_prefix"`
+	PetSuffix          string        `json:"pet_suffix"`
+	PetAbility         string        `json:"pet_ability"`
+	SeedBase           int           `json:"seed_base"`
+	SeedOverlay        int           `json:"seed_overlay"`
+	TreeBase           int           `json:"tree_base"`
+	TreeLeaves         int           `json:"tree_leaves"`
+	SeedColor          Color         `json:"seed_color"`
+	SeedOverlayColor   Color         `json:"seed_overlay_color"`
+	Ingredients        [2]uint16     `json:"ingredients"`
+	GrowTime           int           `json:"grow_time"`
+	Val2               int           `json:"val2"`
+	IsRayman           int           `json:"is_rayman"`
+	ExtraOptions       string        `json:"extra_options"`
+	Texture2           string        `json:"texture2"`
+	ExtraOptions2      string        `json:"extra_options2"`
+	DataPosition80     Bytes80       `json:"data_position_80"`
+	PunchOptions       string        `json:"punch_options"`
+	DataVersion12      Bytes13       `json:"data_version_12"`
+	IntVersion13       int           `json:"int_version_13"`
+	IntVersion14       int           `json:"int_version_14"`
+	DataVersion15      Bytes25       `json:"data_version_15"`
+	StrVersion15       string        `json:"str_version_15"`
+	StrVersion16       string        `json:"str_version_16"`
+	IntVersion17       int           `json:"int_version_17"`
+	IntVersion18       int           `json:"int_version_18"`
+	DataVersion19      Bytes9        `json:"data_version_19"`
+	IntVersion21       int           `json:"int_version_21"`
+	StrVersion22       string        `json:"str_version_22"`
+}
+
+// Splice returns the item whose recipe combines the seeds seedA and seedB,
+// in either order.
+func (itemsData *ItemsData) Splice(seedA, seedB int) (*Item, bool) {
+	for i := range itemsData.Items {
+		item := &itemsData.Items[i]
+		a, b := int(item.Ingredients[0]), int(item.Ingredients[1])
+		if a == 0 && b == 0 {
+			continue
+		}
+		if (a == seedA && b == seedB) || (a == seedB && b == seedA) {
+			return item, true
+		}
+	}
+	return nil, false
+}
+
+// Flags returns the item's EditableType and ItemCategory bits as one set.
+func (item *Item) Flags() Flags {
+	return Flags(item.EditableType) | Flags(item.ItemCategory)<<8
+}
+
+// SetFlags stores f back into EditableType and ItemCategory.
+func (item *Item) SetFlags(f Flags) {
+	item.EditableType = EditableType(f)
+	item.ItemCategory = ItemCategory(f >> 8)
+}
+
+// MarshalJSON encodes item with the symbolic names of its enum fields next
+// to their numeric values, and its flags listed by name. The names are
+// informational; decoding reads only the numeric fields.
+func (item Item) MarshalJSON() ([]byte, error) {
+	type plain Item
+	return json.Marshal(struct {
+		plain
+		Flags             []string `json:"flags"`
+		EditableTypeName  string   `json:"editable_type_name"`
+		ItemCategoryName  string   `json:"item_category_name"`
+		ActionTypeName    string   `json:"action_type_name"`
+		HitSoundTypeName  string   `json:"hit_sound_type_name"`
+		CollisionTypeName string   `json:"collision_type_name"`
+		ClothingTypeName  string   `json:"clothing_type_name"`
+	}{
+		plain:             plain(item),
+		Flags:             item.Flags().Names(),
+		EditableTypeName:  item.EditableType.String(),
+		ItemCategoryName:  item.ItemCategory.String(),
+		ActionTypeName:    item.ActionType.String(),
+		HitSoundTypeName:  item.HitSoundType.String(),
+		CollisionTypeName: item.CollisionType.String(),
+		ClothingTypeName:  item.ClothingType.String(),
+	})
+}
+
+type Color struct {
+	A int `json:"a"`
+	R int `json:"r"`
+	G int `json:"g"`
+	B int `json:"b"`
+}
+
+type ItemsData struct {
+	Version   int      `json:"version"`
+	ItemCount int      `json:"item_count"`
+	Items     []Item   `json:"items"`
+	Trailing  HexBytes `json:"trailing,omitempty"`
+
+	mu    sync.Mutex
+	index *itemIndex
+}
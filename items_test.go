@@ -0,0 +1,27 @@
+package gogt
+
+import "testing"
+
+func TestSplice(t *testing.T) {
+	itemsData := &ItemsData{Items: []Item{
+		{ItemID: 0},
+		{ItemID: 1},
+		{ItemID: 2},
+		{ItemID: 3},
+		{ItemID: 4},
+		{ItemID: 5, Ingredients: [2]uint16{1, 3}},
+	}}
+
+	for _, seeds := range [][2]int{{1, 3}, {3, 1}} {
+		item, ok := itemsData.Splice(seeds[0], seeds[1])
+		if !ok || item.ItemID != 5 {
+			t.Errorf("Splice(%d, %d) = %v, %v; want item 5", seeds[0], seeds[1], item, ok)
+		}
+	}
+	if item, ok := itemsData.Splice(1, 5); ok {
+		t.Errorf("Splice(1, 5) = item %d, want no match", item.ItemID)
+	}
+	if _, ok := itemsData.Splice(0, 0); ok {
+		t.Error("Splice(0, 0) matched an item without a recipe")
+	}
+}
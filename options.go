@@ -0,0 +1,44 @@
+package gogt
+
+import "encoding/binary"
+
+// ByteOrder is the byte order used for the multi-byte fields of an items.dat
+// file. binary.LittleEndian and binary.BigEndian both satisfy it.
+type ByteOrder interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+// Option configures how items.dat data is decoded and encoded.
+type Option func(*options)
+
+type options struct {
+	order    ByteOrder
+	truncate bool
+}
+
+// WithByteOrder overrides the byte order of multi-byte fields. Files shipped
+// with the game client are little-endian, which is the default; pass
+// binary.BigEndian for files produced by older releases of this tool.
+func WithByteOrder(order ByteOrder) Option {
+	return func(o *options) {
+		o.order = order
+	}
+}
+
+// Truncate makes encoding keep only the low bytes of integers too wide for
+// their field, as releases before range checking did, instead of failing
+// with an *EncodeError.
+func Truncate() Option {
+	return func(o *options) {
+		o.truncate = true
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{order: binary.LittleEndian}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,155 @@
+package gogt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// A Patch is a set of local modifications to an items.dat file that can be
+// re-applied after the file is updated upstream. Its JSON form is
+//
+//	{
+//	  "edits": [{"id": 2, "field": "name", "old": "Dirt", "value": "Mud"}],
+//	  "add": [{"item_id": 14000, "name": "Custom Block", ...}]
+//	}
+type Patch struct {
+	Edits []Edit `json:"edits,omitempty"`
+	Add   []Item `json:"add,omitempty"`
+}
+
+// An Edit sets one field of one item. Old, if present, is the value the edit
+// was made against; ApplyPatch reports a Conflict instead of overwriting a
+// value that has changed from it since.
+type Edit struct {
+	ID    int             `json:"id"`
+	Field string          `json:"field"` // JSON name of the Item field
+	Old   json.RawMessage `json:"old,omitempty"`
+	Value json.RawMessage `json:"value"`
+}
+
+// A Conflict is an edit or addition ApplyPatch left out because the base
+// file changed underneath it.
+type Conflict struct {
+	ItemID   int
+	Field    string // JSON name of the field, or "" for an added item
+	Base     any    // value the edit was made against
+	Upstream any    // value now in the file
+	Patch    any    // value the edit sets
+}
+
+func (c Conflict) String() string {
+	if c.Field == "" {
+		return fmt.Sprintf("item %d: already exists as %q; not added", c.ItemID, c.Upstream)
+	}
+	return fmt.Sprintf("item %d field %s: changed upstream from %s to %s; patch sets %s",
+		c.ItemID, c.Field, FormatValue(c.Base), FormatValue(c.Upstream), FormatValue(c.Patch))
+}
+
+// CreatePatch returns the edits and additions that turn base into modified.
+// Patches cannot remove items, so items missing from modified are an error.
+func CreatePatch(base, modified *ItemsData) (*Patch, error) {
+	p := &Patch{}
+	for _, c := range Diff(base, modified) {
+		switch c.Kind {
+		case ChangeRemoved:
+			return nil, fmt.Errorf("item %d (%s) was removed; patches cannot remove items", c.ItemID, c.Name)
+		case ChangeAdded:
+			item, _ := modified.ByID(c.ItemID)
+			p.Add = append(p.Add, *item)
+		case ChangeChanged:
+			for _, f := range c.Fields {
+				old, err := json.Marshal(f.Old)
+				if err != nil {
+					return nil, err
+				}
+				value, err := json.Marshal(f.New)
+				if err != nil {
+					return nil, err
+				}
+				p.Edits = append(p.Edits, Edit{ID: c.ItemID, Field: f.Field, Old: old, Value: value})
+			}
+		}
+	}
+	return p, nil
+}
+
+// ApplyPatch applies p to itemsData. An edit whose field still holds its Old
+// value, or has no Old, is applied; one whose field already holds the new
+// value is skipped; any other is left out and reported as a Conflict. Added
+// items are appended unless their ID is taken, which is also a Conflict.
+//
+// Malformed edits, such as unknown items or fields, are an error and leave
+// itemsData unchanged.
+func (itemsData *ItemsData) ApplyPatch(p *Patch) ([]Conflict, error) {
+	type resolved struct {
+		item       *Item
+		f          field
+		old, value reflect.Value
+	}
+	edits := make([]resolved, len(p.Edits))
+	for i, e := range p.Edits {
+		item, ok := itemsData.ByID(e.ID)
+		if !ok {
+			return nil, fmt.Errorf("edit %d: no item %d", i, e.ID)
+		}
+		f, ok := fieldByJSONName(e.Field)
+		if !ok || f.name == "ItemID" {
+			return nil, fmt.Errorf("edit %d: item %d: no editable field %q", i, e.ID, e.Field)
+		}
+		if f.minVersion > itemsData.Version {
+			return nil, fmt.Errorf("edit %d: item %d: version %d files do not store %s", i, e.ID, itemsData.Version, e.Field)
+		}
+		r := resolved{item: item, f: f}
+		var err error
+		if r.value, err = decodeFieldValue(f, e.Value); err != nil {
+			return nil, fmt.Errorf("edit %d: item %d field %s: %w", i, e.ID, e.Field, err)
+		}
+		if len(bytes.TrimSpace(e.Old)) > 0 {
+			if r.old, err = decodeFieldValue(f, e.Old); err != nil {
+				return nil, fmt.Errorf("edit %d: item %d field %s: old value: %w", i, e.ID, e.Field, err)
+			}
+		}
+		edits[i] = r
+	}
+
+	var conflicts []Conflict
+	for _, r := range edits {
+		cur := reflect.ValueOf(r.item).Elem().Field(r.f.index)
+		switch {
+		case reflect.DeepEqual(cur.Interface(), r.value.Interface()):
+		case !r.old.IsValid() || reflect.DeepEqual(cur.Interface(), r.old.Interface()):
+			cur.Set(r.value)
+		default:
+			conflicts = append(conflicts, Conflict{
+				ItemID:   r.item.ItemID,
+				Field:    r.f.jsonName,
+				Base:     r.old.Interface(),
+				Upstream: cur.Interface(),
+				Patch:    r.value.Interface(),
+			})
+		}
+	}
+
+	for _, item := range p.Add {
+		if existing, ok := itemsData.ByID(item.ItemID); ok {
+			if !reflect.DeepEqual(*existing, item) {
+				conflicts = append(conflicts, Conflict{ItemID: item.ItemID, Upstream: existing.Name, Patch: item})
+			}
+			continue
+		}
+		itemsData.Items = append(itemsData.Items, item)
+	}
+	itemsData.ItemCount = len(itemsData.Items)
+	return conflicts, nil
+}
+
+// decodeFieldValue parses data as a value of the Item field f.
+func decodeFieldValue(f field, data json.RawMessage) (reflect.Value, error) {
+	v := reflect.New(reflect.TypeOf(Item{}).Field(f.index).Type)
+	if err := json.Unmarshal(data, v.Interface()); err != nil {
+		return reflect.Value{}, err
+	}
+	return v.Elem(), nil
+}
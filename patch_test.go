@@ -0,0 +1,106 @@
+package gogt
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPatchRoundTrip(t *testing.T) {
+	base := fixture(LatestVersion)
+	modified := fixture(LatestVersion)
+	modified.Items[1].Name = "Renamed"
+	modified.Items[2].BreakHits = RawBreakHits(12)
+	modified.Items[3].SeedColor.R = 99
+	if _, err := modified.AddItem(NewItem("Custom")); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := CreatePatch(base, modified)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Edits) != 3 || len(p.Add) != 2 {
+		t.Fatalf("patch has %d edits and %d additions, want 3 and 2", len(p.Edits), len(p.Add))
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Patch
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	conflicts, err := base.ApplyPatch(&decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conflicts != nil {
+		t.Fatalf("conflicts = %v", conflicts)
+	}
+	if !reflect.DeepEqual(base.Items, modified.Items) || base.ItemCount != modified.ItemCount {
+		t.Fatal("applying the patch did not reproduce the modified file")
+	}
+}
+
+func TestPatchConflicts(t *testing.T) {
+	p := &Patch{
+		Edits: []Edit{
+			{ID: 0, Field: "name", Old: json.RawMessage(`"Fixture Item "`), Value: json.RawMessage(`"Mine"`)},
+			{ID: 1, Field: "rarity", Old: json.RawMessage(`999`), Value: json.RawMessage(`5`)},
+			{ID: 2, Field: "rarity", Old: json.RawMessage(`999`), Value: json.RawMessage(`7`)},
+			{ID: 3, Field: "texture", Value: json.RawMessage(`"forced.rttex"`)},
+		},
+		Add: []Item{{ItemID: 3, Name: "Clash"}},
+	}
+	upstream := fixture(LatestVersion)
+	upstream.Items[0].Name = "Upstream"
+	upstream.Items[2].Rarity = 7
+
+	conflicts, err := upstream.ApplyPatch(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Conflict{
+		{ItemID: 0, Field: "name", Base: "Fixture Item ", Upstream: "Upstream", Patch: "Mine"},
+		{ItemID: 3, Upstream: upstream.Items[3].Name, Patch: Item{ItemID: 3, Name: "Clash"}},
+	}
+	if !reflect.DeepEqual(conflicts, want) {
+		t.Fatalf("conflicts =\n%v\nwant\n%v", conflicts, want)
+	}
+	if upstream.Items[0].Name != "Upstream" || upstream.Items[1].Rarity != 5 || upstream.Items[2].Rarity != 7 || upstream.Items[3].Texture != "forced.rttex" {
+		t.Fatalf("items after patch: %q %d %d %q", upstream.Items[0].Name, upstream.Items[1].Rarity, upstream.Items[2].Rarity, upstream.Items[3].Texture)
+	}
+	if len(upstream.Items) != 4 {
+		t.Fatalf("conflicting addition was appended")
+	}
+}
+
+func TestPatchErrors(t *testing.T) {
+	tests := []Edit{
+		{ID: 99, Field: "name", Value: json.RawMessage(`"x"`)},
+		{ID: 0, Field: "nope", Value: json.RawMessage(`1`)},
+		{ID: 0, Field: "item_id", Value: json.RawMessage(`5`)},
+		{ID: 0, Field: "rarity", Value: json.RawMessage(`"high"`)},
+		{ID: 0, Field: "str_version_22", Value: json.RawMessage(`"x"`)},
+	}
+	for _, e := range tests {
+		itemsData := fixture(18)
+		_, err := itemsData.ApplyPatch(&Patch{Edits: []Edit{{ID: 1, Field: "name", Value: json.RawMessage(`"applied"`)}, e}})
+		if err == nil {
+			t.Errorf("ApplyPatch(%+v) succeeded", e)
+		}
+		if itemsData.Items[1].Name == "applied" {
+			t.Errorf("ApplyPatch(%+v) changed items before failing", e)
+		}
+	}
+
+	base := fixture(LatestVersion)
+	modified := fixture(LatestVersion)
+	modified.Items = modified.Items[:3]
+	if _, err := CreatePatch(base, modified); err == nil {
+		t.Error("CreatePatch accepted a removed item")
+	}
+}
@@ -0,0 +1,172 @@
+package gogt
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type fieldKind int
+
+const (
+	kindUint      fieldKind = iota // unsigned integer, width 1, 2 or 4 bytes, or an array of them
+	kindString                     // string with a 16-bit length prefix
+	kindBytes                      // opaque byte array of width bytes
+	kindColor                      // four bytes: A, R, G, B
+	kindBreakHits                  // one byte, see BreakHits
+)
+
+// A field describes one on-disk item field. The decoder and the encoder both
+// walk itemSchema in order, so the layout of every version lives here and
+// nowhere else.
+type field struct {
+	name       string // Go field name on Item
+	kind       fieldKind
+	width      int  // size in bytes for fixed-size kinds, per element for arrays
+	minVersion int  // first items.dat version carrying the field
+	encrypted  bool // string XOR-ed with itemsSecretKey
+
+	index    int    // index of the field in Item
+	jsonName string // name used in JSON
+}
+
+var itemSchema = []field{
+	{name: "ItemID", kind: kindUint, width: 4},
+	{name: "EditableType", kind: kindUint, width: 1},
+	{name: "ItemCategory", kind: kindUint, width: 1},
+	{name: "ActionType", kind: kindUint, width: 1},
+	{name: "HitSoundType", kind: kindUint, width: 1},
+	{name: "Name", kind: kindString, encrypted: true},
+	{name: "Texture", kind: kindString},
+	{name: "TextureHash", kind: kindUint, width: 4},
+	{name: "ItemKind", kind: kindUint, width: 1},
+	{name: "Val1", kind: kindUint, width: 4},
+	{name: "TextureX", kind: kindUint, width: 1},
+	{name: "TextureY", kind: kindUint, width: 1},
+	{name: "SpreadType", kind: kindUint, width: 1},
+	{name: "IsStripeyWallpaper", kind: kindUint, width: 1},
+	{name: "CollisionType", kind: kindUint, width: 1},
+	{name: "BreakHits", kind: kindBreakHits, width: 1},
+	{name: "DropChance", kind: kindUint, width: 4},
+	{name: "ClothingType", kind: kindUint, width: 1},
+	{name: "Rarity", kind: kindUint, width: 2},
+	{name: "MaxAmount", kind: kindUint, width: 1},
+	{name: "ExtraFile", kind: kindString},
+	{name: "ExtraFileHash", kind: kindUint, width: 4},
+	{name: "AudioVolume", kind: kindUint, width: 4},
+	{name: "PetName", kind: kindString},
+	{name: "PetPrefix", kind: kindString},
+	{name: "PetSuffix", kind: kindString},
+	{name: "PetAbility", kind: kindString},
+	{name: "SeedBase", kind: kindUint, width: 1},
+	{name: "SeedOverlay", kind: kindUint, width: 1},
+	{name: "TreeBase", kind: kindUint, width: 1},
+	{name: "TreeLeaves", kind: kindUint, width: 1},
+	{name: "SeedColor", kind: kindColor, width: 4},
+	{name: "SeedOverlayColor", kind: kindColor, width: 4},
+	{name: "Ingredients", kind: kindUint, width: 2},
+	{name: "GrowTime", kind: kindUint, width: 4},
+	{name: "Val2", kind: kindUint, width: 2},
+	{name: "IsRayman", kind: kindUint, width: 2},
+	{name: "ExtraOptions", kind: kindString},
+	{name: "Texture2", kind: kindString},
+	{name: "ExtraOptions2", kind: kindString},
+	{name: "DataPosition80", kind: kindBytes, width: 80},
+	{name: "PunchOptions", kind: kindString, minVersion: 11},
+	{name: "DataVersion12", kind: kindBytes, width: 13, minVersion: 12},
+	{name: "IntVersion13", kind: kindUint, width: 4, minVersion: 13},
+	{name: "IntVersion14", kind: kindUint, width: 4, minVersion: 14},
+	{name: "DataVersion15", kind: kindBytes, width: 25, minVersion: 15},
+	{name: "StrVersion15", kind: kindString, minVersion: 15},
+	{name: "StrVersion16", kind: kindString, minVersion: 16},
+	{name: "IntVersion17", kind: kindUint, width: 4, minVersion: 17},
+	{name: "IntVersion18", kind: kindUint, width: 4, minVersion: 18},
+	{name: "DataVersion19", kind: kindBytes, width: 9, minVersion: 19},
+	{name: "IntVersion21", kind: kindUint, width: 2, minVersion: 21},
+	{name: "StrVersion22", kind: kindString, minVersion: 22},
+}
+
+func init() {
+	t := reflect.TypeOf(Item{})
+	for i := range itemSchema {
+		f := &itemSchema[i]
+		sf, ok := t.FieldByName(f.name)
+		if !ok {
+			panic(fmt.Sprintf("gogt: schema field %s is not a field of Item", f.name))
+		}
+		f.index = sf.Index[0]
+		f.jsonName = jsonName(sf)
+	}
+}
+
+func jsonName(sf reflect.StructField) string {
+	tag := sf.Tag.Get("json")
+	for i := 0; i < len(tag); i++ {
+		if tag[i] == ',' {
+			tag = tag[:i]
+			break
+		}
+	}
+	if tag == "" {
+		return sf.Name
+	}
+	return tag
+}
+
+// fieldsFor returns the schema fields present in a version items.dat file.
+func fieldsFor(version int) []field {
+	fields := make([]field, 0, len(itemSchema))
+	for _, f := range itemSchema {
+		if version >= f.minVersion {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// checkRange reports an error if an integer in v, the value of f, does not
+// fit the field's on-disk width.
+func (f field) checkRange(v reflect.Value) error {
+	switch f.kind {
+	case kindUint:
+		if v.Kind() != reflect.Array {
+			return fitsUint(v, f.width)
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := fitsUint(v.Index(i), f.width); err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+	case kindColor:
+		for i := 0; i < v.NumField(); i++ {
+			if err := fitsUint(v.Field(i), 1); err != nil {
+				return fmt.Errorf("%s: %w", v.Type().Field(i).Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func fitsUint(v reflect.Value, width int) error {
+	max := uint64(1)<<(8*width) - 1
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := v.Int(); n < 0 || uint64(n) > max {
+			return fmt.Errorf("%d out of range 0-%d", n, max)
+		}
+	default:
+		if n := v.Uint(); n > max {
+			return fmt.Errorf("%d out of range 0-%d", n, max)
+		}
+	}
+	return nil
+}
+
+// fieldByJSONName returns the schema field whose JSON name is name.
+func fieldByJSONName(name string) (field, bool) {
+	for _, f := range itemSchema {
+		if f.jsonName == name {
+			return f, true
+		}
+	}
+	return field{}, false
+}
@@ -0,0 +1,32 @@
+package gogt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSchemaCoversItem(t *testing.T) {
+	seen := map[string]bool{}
+	for _, f := range itemSchema {
+		if seen[f.name] {
+			t.Errorf("field %s appears twice in the schema", f.name)
+		}
+		seen[f.name] = true
+	}
+	typ := reflect.TypeOf(Item{})
+	for i := 0; i < typ.NumField(); i++ {
+		if name := typ.Field(i).Name; !seen[name] {
+			t.Errorf("Item.%s has no schema entry", name)
+		}
+	}
+}
+
+func TestSchemaVersionsAreOrdered(t *testing.T) {
+	last := 0
+	for _, f := range itemSchema {
+		if f.minVersion < last {
+			t.Errorf("field %s (version %d) follows a version %d field", f.name, f.minVersion, last)
+		}
+		last = f.minVersion
+	}
+}
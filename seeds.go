@@ -0,0 +1,80 @@
+package gogt
+
+import "fmt"
+
+// In items.dat every item at an even ID is followed by its seed at ID+1.
+
+// IsSeed reports whether item is a seed.
+func (item *Item) IsSeed() bool {
+	return item.ActionType == ActionSeed
+}
+
+// SeedOf returns the seed that grows into the item with the given ID.
+func (itemsData *ItemsData) SeedOf(id int) (*Item, bool) {
+	block, ok := itemsData.ByID(id)
+	if !ok || block.IsSeed() {
+		return nil, false
+	}
+	seed, ok := itemsData.ByID(id + 1)
+	if !ok || !seed.IsSeed() {
+		return nil, false
+	}
+	return seed, true
+}
+
+// BlockOf returns the item that the seed with the given ID grows into.
+func (itemsData *ItemsData) BlockOf(seedID int) (*Item, bool) {
+	seed, ok := itemsData.ByID(seedID)
+	if !ok || !seed.IsSeed() {
+		return nil, false
+	}
+	block, ok := itemsData.ByID(seedID - 1)
+	if !ok || block.IsSeed() {
+		return nil, false
+	}
+	return block, true
+}
+
+// A SeedPairError describes an item that breaks the block/seed pairing.
+type SeedPairError struct {
+	ItemID int
+	Reason string
+}
+
+func (e *SeedPairError) Error() string {
+	return fmt.Sprintf("item %d: %s", e.ItemID, e.Reason)
+}
+
+// CheckSeedPairs verifies that every even ID holds a non-seed item whose
+// seed sits at the next ID, and every odd ID holds such a seed. It returns
+// one *SeedPairError per problem found.
+func (itemsData *ItemsData) CheckSeedPairs() []error {
+	var errs []error
+	for i := range itemsData.Items {
+		item := &itemsData.Items[i]
+		if reason := itemsData.seedPairProblem(item); reason != "" {
+			errs = append(errs, &SeedPairError{item.ItemID, reason})
+		}
+	}
+	return errs
+}
+
+// seedPairProblem describes how item breaks the pairing, or returns "".
+func (itemsData *ItemsData) seedPairProblem(item *Item) string {
+	if item.ItemID%2 == 0 {
+		if item.IsSeed() {
+			return "seed at an even ID"
+		}
+		if _, ok := itemsData.SeedOf(item.ItemID); !ok {
+			return fmt.Sprintf("no seed at ID %d", item.ItemID+1)
+		}
+		return ""
+	}
+	if !item.IsSeed() {
+		return fmt.Sprintf("odd ID holds %s, not a seed", item.ActionType)
+	}
+	if _, ok := itemsData.BlockOf(item.ItemID); !ok {
+		return fmt.Sprintf("no block at ID %d", item.ItemID-1)
+	}
+	return ""
+}
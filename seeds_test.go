@@ -0,0 +1,51 @@
+package gogt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func seedFixture() *ItemsData {
+	return &ItemsData{Items: []Item{
+		{ItemID: 0, ActionType: ActionFist, Name: "Blank"},
+		{ItemID: 1, ActionType: ActionSeed, Name: "Blank Seed"},
+		{ItemID: 2, ActionType: ActionForeground, Name: "Dirt"},
+		{ItemID: 3, ActionType: ActionSeed, Name: "Dirt Seed", GrowTime: 31},
+	}}
+}
+
+func TestSeedOfAndBlockOf(t *testing.T) {
+	itemsData := seedFixture()
+	if seed, ok := itemsData.SeedOf(2); !ok || seed.Name != "Dirt Seed" {
+		t.Fatalf("SeedOf(2) = %v, %v", seed, ok)
+	}
+	if block, ok := itemsData.BlockOf(3); !ok || block.Name != "Dirt" {
+		t.Fatalf("BlockOf(3) = %v, %v", block, ok)
+	}
+	if _, ok := itemsData.SeedOf(3); ok {
+		t.Fatal("SeedOf(seed) succeeded")
+	}
+	if _, ok := itemsData.BlockOf(2); ok {
+		t.Fatal("BlockOf(block) succeeded")
+	}
+	if !itemsData.Items[3].IsSeed() || itemsData.Items[2].IsSeed() {
+		t.Fatal("IsSeed misreports")
+	}
+}
+
+func TestCheckSeedPairs(t *testing.T) {
+	if errs := seedFixture().CheckSeedPairs(); len(errs) != 0 {
+		t.Fatalf("CheckSeedPairs() = %v, want none", errs)
+	}
+
+	itemsData := seedFixture()
+	itemsData.Items[3].ActionType = ActionForeground
+	itemsData.Items = append(itemsData.Items, Item{ItemID: 4, ActionType: ActionSeed})
+	var got []int
+	for _, err := range itemsData.CheckSeedPairs() {
+		got = append(got, err.(*SeedPairError).ItemID)
+	}
+	if want := []int{2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("CheckSeedPairs() reported items %v, want %v", got, want)
+	}
+}
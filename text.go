@@ -0,0 +1,232 @@
+package gogt
+
+import (
+	"bufio"
+	"encoding"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// WriteText writes itemsData in the add_item\ text format.
+func WriteText(w io.Writer, itemsData *ItemsData) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "//Credit: IProgramInCPP & GrowtopiaNoobs\n//Format: add_item\\%s\n//NOTE: There are several items, for the breakhits part, add 'r'.\n//Example: 184r\n//What does it mean? So, adding 'r' to breakhits makes it raw breakhits, meaning, if you add 'r' to breakhits, when encoding items.dat, the encoder won't multiply it by 6.\n\nversion\\%d\nitemCount\\%d\n", strings.Join(getKeys(Item{}), "\\"), itemsData.Version, itemsData.ItemCount)
+	if len(itemsData.Trailing) > 0 {
+		fmt.Fprintf(bw, "trailing\\%s\n", marshalHex(itemsData.Trailing))
+	}
+	fmt.Fprintln(bw)
+
+	for _, item := range itemsData.Items { // Iterate over Items slice
+		values := getValues(item)
+		for i, v := range values {
+			if strings.ContainsAny(v, "\\\r\n") {
+				return fmt.Errorf("item %d: %s contains a backslash or line break and cannot be written as text", item.ItemID, getKeys(item)[i])
+			}
+		}
+		fmt.Fprintf(bw, "add_item\\%s\n", strings.Join(values, "\\"))
+	}
+	return bw.Flush()
+}
+
+// ReadText parses the add_item\ text format produced by WriteText. If the
+// file carries a //Format: line, its column order is used; otherwise the
+// columns are expected in the order WriteText emits them.
+func ReadText(r io.Reader) (*ItemsData, error) {
+	itemsData := &ItemsData{ItemCount: -1}
+	keys := getKeys(Item{})
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimRight(sc.Text(), "\r")
+		if strings.HasPrefix(line, "//Format: add_item\\") {
+			keys = strings.Split(strings.TrimPrefix(line, "//Format: add_item\\"), "\\")
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, "\\")
+		switch key {
+		case "version":
+			v, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid version: %w", lineNo, err)
+			}
+			itemsData.Version = v
+		case "itemCount":
+			v, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid itemCount: %w", lineNo, err)
+			}
+			itemsData.ItemCount = v
+		case "trailing":
+			if err := itemsData.Trailing.UnmarshalText([]byte(value)); err != nil {
+				return nil, fmt.Errorf("line %d: trailing: %w", lineNo, err)
+			}
+		case "add_item":
+			item, err := parseItem(keys, strings.Split(value, "\\"))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			itemsData.Items = append(itemsData.Items, item)
+		default:
+			return nil, fmt.Errorf("line %d: unknown directive %q", lineNo, key)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if itemsData.ItemCount < 0 {
+		itemsData.ItemCount = len(itemsData.Items)
+	}
+	return itemsData, nil
+}
+
+func getKeys(item Item) []string {
+	t := reflect.TypeOf(item)
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		keys = append(keys, t.Field(i).Name)
+	}
+	return keys
+}
+
+func getValues(item Item) []string {
+	var values []string
+	rv := reflect.ValueOf(item)
+	for i := 0; i < rv.NumField(); i++ {
+		values = append(values, formatValue(rv.Field(i)))
+	}
+	return values
+}
+
+// FormatValue formats v, the value of an Item field, the way the text
+// format writes it, with enum names alongside their numbers.
+func FormatValue(v any) string {
+	return formatValue(reflect.ValueOf(v))
+}
+
+// formatValue renders one field for the text format. Named integer types
+// carry their symbolic name in parentheses after the number; arrays and
+// structs are written as comma-separated lists.
+func formatValue(v reflect.Value) string {
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		if err == nil {
+			return string(text)
+		}
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return withName(strconv.FormatInt(v.Int(), 10), v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return withName(strconv.FormatUint(v.Uint(), 10), v)
+	case reflect.String:
+		return v.String()
+	case reflect.Array:
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = formatValue(v.Index(i))
+		}
+		return strings.Join(parts, ",")
+	case reflect.Struct:
+		parts := make([]string, v.NumField())
+		for i := range parts {
+			parts[i] = formatValue(v.Field(i))
+		}
+		return strings.Join(parts, ",")
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+func withName(number string, v reflect.Value) string {
+	s, ok := v.Interface().(fmt.Stringer)
+	if !ok {
+		return number
+	}
+	// Unnamed values print as Type(n), which adds nothing to the number.
+	if name := s.String(); !strings.Contains(name, "(") {
+		return number + " (" + name + ")"
+	}
+	return number
+}
+
+func parseItem(keys, values []string) (Item, error) {
+	var item Item
+	if len(values) != len(keys) {
+		return item, fmt.Errorf("expected %d values, got %d", len(keys), len(values))
+	}
+	rv := reflect.ValueOf(&item).Elem()
+	for i, k := range keys {
+		f := rv.FieldByName(k)
+		if !f.IsValid() {
+			return item, fmt.Errorf("unknown field %q", k)
+		}
+		if err := setValue(f, values[i]); err != nil {
+			return item, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+	return item, nil
+}
+
+// setValue parses s as written by formatValue into f.
+func setValue(f reflect.Value, s string) error {
+	if u, ok := f.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch f.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(stripName(s), 10, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(stripName(s), 10, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetUint(n)
+	case reflect.String:
+		f.SetString(s)
+	case reflect.Array:
+		parts := strings.Split(s, ",")
+		if len(parts) != f.Len() {
+			return fmt.Errorf("expected %d comma-separated values, got %q", f.Len(), s)
+		}
+		for i, p := range parts {
+			if err := setValue(f.Index(i), strings.TrimSpace(p)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		parts := strings.Split(s, ",")
+		if len(parts) != f.NumField() {
+			return fmt.Errorf("expected %d comma-separated values, got %q", f.NumField(), s)
+		}
+		for i, p := range parts {
+			if err := setValue(f.Field(i), strings.TrimSpace(p)); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported field type %s", f.Type())
+	}
+	return nil
+}
+
+// stripName drops the " (Name)" suffix formatValue adds to named integers.
+func stripName(s string) string {
+	if i := strings.Index(s, " ("); i >= 0 {
+		return s[:i]
+	}
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,370 @@
+package gogt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ActionType is what an item does when placed, worn or used.
+type ActionType uint8
+
+const (
+	ActionFist ActionType = iota
+	ActionWrench
+	ActionUserDoor
+	ActionLock
+	ActionGems
+	ActionTreasure
+	ActionDeadlyBlock
+	ActionTrampoline
+	ActionConsumable
+	ActionGateway
+	ActionSign
+	ActionSFXForeground
+	ActionToggleableForeground
+	ActionMainDoor
+	ActionPlatform
+	ActionBedrock
+	ActionLava
+	ActionForeground
+	ActionBackground
+	ActionSeed
+	ActionClothes
+	ActionAnimatedForeground
+	ActionSFXBackground
+	ActionToggleableBackground
+	ActionBouncy
+	ActionSpike
+	ActionPortal
+	ActionCheckpoint
+	ActionSheetMusic
+	ActionIce
+	_
+	ActionSwitcheroo
+	ActionChest
+	ActionMailbox
+	ActionBulletinBoard
+	ActionPinata
+	ActionDice
+	ActionChemical
+	ActionProvider
+	ActionLab
+	ActionAchievement
+	ActionWeatherMachine
+	ActionScoreboard
+	ActionSungate
+	ActionProfile
+	ActionDeadlyIfOn
+	ActionHeartMonitor
+	ActionDonationBox
+	ActionToybox
+	ActionMannequin
+	ActionSecurityCamera
+	ActionMagicEgg
+	ActionGameResources
+	ActionGameGenerator
+	ActionXenonite
+	ActionDressUp
+	ActionCrystal
+	ActionBurglar
+	ActionCompactor
+	ActionSpotlight
+	ActionWind
+	ActionDisplayBlock
+	ActionVending
+	ActionFishTank
+	ActionPetFish
+	ActionSolar
+	ActionForge
+	ActionGivingTree
+	ActionGivingTreeStump
+	ActionSteampunk
+	ActionSteamLavaIfOn
+	ActionSteamOrgan
+	ActionTamagotchi
+	ActionSewing
+	ActionFlag
+	ActionLobsterTrap
+	ActionArtCanvas
+	ActionBattleCage
+	ActionPetTrainer
+	ActionSteamEngine
+	ActionLockBot
+	ActionWeatherSpecial
+	ActionSpiritStorage
+	ActionDisplayShelf
+	ActionVIPEntrance
+	ActionChallengeTimer
+	ActionChallengeFlag
+	ActionFishMount
+	ActionPortrait
+	ActionWeatherSpecial2
+	ActionFossil
+	ActionFossilPrep
+	ActionDNAMachine
+	ActionBlaster
+	ActionValhowla
+	ActionChemsynth
+	ActionChemtank
+	ActionStorage
+	ActionOven
+	ActionSuperMusic
+	ActionGeigerCharge
+	ActionAdventureReset
+	ActionTombRobber
+	ActionFaction
+	ActionRedFaction
+	ActionGreenFaction
+	ActionBlueFaction
+	ActionArtifact
+	ActionTrampolineMomentum
+	ActionFishGotchiTank
+	ActionFishingBlock
+	ActionItemSucker
+	ActionItemPlanter
+	ActionRobot
+	ActionCommand
+	ActionTicket
+	ActionStatsBlock
+	ActionFieldNode
+	ActionOuijaBoard
+	ActionArchitectMachine
+	ActionStarship
+)
+
+var actionTypeNames = [...]string{
+	"Fist", "Wrench", "UserDoor", "Lock", "Gems", "Treasure", "DeadlyBlock",
+	"Trampoline", "Consumable", "Gateway", "Sign", "SFXForeground",
+	"ToggleableForeground", "MainDoor", "Platform", "Bedrock", "Lava",
+	"Foreground", "Background", "Seed", "Clothes", "AnimatedForeground",
+	"SFXBackground", "ToggleableBackground", "Bouncy", "Spike", "Portal",
+	"Checkpoint", "SheetMusic", "Ice", "", "Switcheroo", "Chest", "Mailbox",
+	"BulletinBoard", "Pinata", "Dice", "Chemical", "Provider", "Lab",
+	"Achievement", "WeatherMachine", "Scoreboard", "Sungate", "Profile",
+	"DeadlyIfOn", "HeartMonitor", "DonationBox", "Toybox", "Mannequin",
+	"SecurityCamera", "MagicEgg", "GameResources", "GameGenerator", "Xenonite",
+	"DressUp", "Crystal", "Burglar", "Compactor", "Spotlight", "Wind",
+	"DisplayBlock", "Vending", "FishTank", "PetFish", "Solar", "Forge",
+	"GivingTree", "GivingTreeStump", "Steampunk", "SteamLavaIfOn", "SteamOrgan",
+	"Tamagotchi", "Sewing", "Flag", "LobsterTrap", "ArtCanvas", "BattleCage",
+	"PetTrainer", "SteamEngine", "LockBot", "WeatherSpecial", "SpiritStorage",
+	"DisplayShelf", "VIPEntrance", "ChallengeTimer", "ChallengeFlag",
+	"FishMount", "Portrait", "WeatherSpecial2", "Fossil", "FossilPrep",
+	"DNAMachine", "Blaster", "Valhowla", "Chemsynth", "Chemtank", "Storage",
+	"Oven", "SuperMusic", "GeigerCharge", "AdventureReset", "TombRobber",
+	"Faction", "RedFaction", "GreenFaction", "BlueFaction", "Artifact",
+	"TrampolineMomentum", "FishGotchiTank", "FishingBlock", "ItemSucker",
+	"ItemPlanter", "Robot", "Command", "Ticket", "StatsBlock", "FieldNode",
+	"OuijaBoard", "ArchitectMachine", "Starship",
+}
+
+func (t ActionType) String() string {
+	return enumName(actionTypeNames[:], int(t), "ActionType")
+}
+
+// EditableType is a set of bits describing how a placed item behaves.
+type EditableType uint8
+
+const (
+	EditableFlippable EditableType = 1 << iota
+	EditableEditable
+	EditableSeedless
+	EditablePermanent
+	EditableDropless
+	EditableNoSelf
+	EditableNoShadow
+	EditableWorldLocked
+)
+
+var editableTypeNames = [...]string{
+	"Flippable", "Editable", "Seedless", "Permanent", "Dropless", "NoSelf",
+	"NoShadow", "WorldLocked",
+}
+
+// String lists the set bits separated by "|", or "None" when empty.
+func (e EditableType) String() string {
+	return bitNames(editableTypeNames[:], uint(e))
+}
+
+// ItemCategory is a set of category bits describing how an item may be
+// obtained and handled.
+type ItemCategory uint8
+
+const (
+	CategoryBeta ItemCategory = 1 << iota
+	CategoryAutoPickup
+	CategoryModOnly
+	CategoryRandomGrow
+	CategoryPublic
+	CategoryForeground
+	CategoryHoliday
+	CategoryUntradeable
+)
+
+var itemCategoryNames = [...]string{
+	"Beta", "AutoPickup", "ModOnly", "RandomGrow", "Public", "Foreground",
+	"Holiday", "Untradeable",
+}
+
+// String lists the set bits separated by "|", or "None" when empty.
+func (c ItemCategory) String() string {
+	return bitNames(itemCategoryNames[:], uint(c))
+}
+
+// Flags combines EditableType (low byte) and ItemCategory (high byte) into
+// one set, the way the game client treats them. The guild-item marker is
+// not part of these bytes and has no flag here.
+type Flags uint16
+
+const (
+	FlagFlippable   = Flags(EditableFlippable)
+	FlagEditable    = Flags(EditableEditable)
+	FlagSeedless    = Flags(EditableSeedless)
+	FlagPermanent   = Flags(EditablePermanent)
+	FlagDropless    = Flags(EditableDropless)
+	FlagNoSelf      = Flags(EditableNoSelf)
+	FlagNoShadow    = Flags(EditableNoShadow)
+	FlagWorldLocked = Flags(EditableWorldLocked)
+	FlagBeta        = Flags(CategoryBeta) << 8
+	FlagAutoPickup  = Flags(CategoryAutoPickup) << 8
+	FlagModOnly     = Flags(CategoryModOnly) << 8
+	FlagRandomGrow  = Flags(CategoryRandomGrow) << 8
+	FlagPublic      = Flags(CategoryPublic) << 8
+	FlagForeground  = Flags(CategoryForeground) << 8
+	FlagHoliday     = Flags(CategoryHoliday) << 8
+	FlagUntradeable = Flags(CategoryUntradeable) << 8
+)
+
+var flagNames = append(append([]string{}, editableTypeNames[:]...), itemCategoryNames[:]...)
+
+// Has reports whether every flag in flag is set.
+func (f Flags) Has(flag Flags) bool {
+	return f&flag == flag
+}
+
+// Set turns on every flag in flag.
+func (f *Flags) Set(flag Flags) {
+	*f |= flag
+}
+
+// Clear turns off every flag in flag.
+func (f *Flags) Clear(flag Flags) {
+	*f &^= flag
+}
+
+// Names returns the names of the set flags in bit order.
+func (f Flags) Names() []string {
+	names := []string{}
+	for i, name := range flagNames {
+		if f&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// String lists the set flags separated by "|", or "None" when empty.
+func (f Flags) String() string {
+	return bitNames(flagNames, uint(f))
+}
+
+// CollisionType is how players collide with a placed block.
+type CollisionType uint8
+
+const (
+	CollisionNone CollisionType = iota
+	CollisionSolid
+	CollisionJumpThrough
+	CollisionGateway
+	CollisionIfOff
+	CollisionOneWay
+	CollisionVIP
+	CollisionJumpDown
+	CollisionAdventure
+	CollisionIfOn
+	CollisionFaction
+	CollisionGuild
+	CollisionCloud
+)
+
+var collisionTypeNames = [...]string{
+	"None", "Solid", "JumpThrough", "Gateway", "IfOff", "OneWay", "VIP",
+	"JumpDown", "Adventure", "IfOn", "Faction", "Guild", "Cloud",
+}
+
+func (t CollisionType) String() string {
+	return enumName(collisionTypeNames[:], int(t), "CollisionType")
+}
+
+// ClothingType is the body slot a piece of clothing occupies.
+type ClothingType uint8
+
+const (
+	ClothingHair ClothingType = iota
+	ClothingShirt
+	ClothingPants
+	ClothingFeet
+	ClothingFace
+	ClothingHand
+	ClothingBack
+	ClothingMask
+	ClothingNecklace
+	ClothingAnces
+)
+
+var clothingTypeNames = [...]string{
+	"Hair", "Shirt", "Pants", "Feet", "Face", "Hand", "Back", "Mask",
+	"Necklace", "Ances",
+}
+
+func (t ClothingType) String() string {
+	return enumName(clothingTypeNames[:], int(t), "ClothingType")
+}
+
+// HitSoundType is the material sound played when a block is punched.
+type HitSoundType uint8
+
+const (
+	HitSoundWood HitSoundType = iota
+	HitSoundGlass
+	HitSoundRock
+	HitSoundMetal
+)
+
+var hitSoundTypeNames = [...]string{"Wood", "Glass", "Rock", "Metal"}
+
+func (t HitSoundType) String() string {
+	return enumName(hitSoundTypeNames[:], int(t), "HitSoundType")
+}
+
+// ItemKind is the visual effect class of an item. Its values have not been
+// mapped yet, so it only prints as a number and has no name in JSON output.
+type ItemKind uint8
+
+func (k ItemKind) String() string {
+	return enumName(nil, int(k), "ItemKind")
+}
+
+func enumName(names []string, v int, typeName string) string {
+	if v < len(names) && names[v] != "" {
+		return names[v]
+	}
+	return fmt.Sprintf("%s(%d)", typeName, v)
+}
+
+func bitNames(names []string, v uint) string {
+	if v == 0 {
+		return "None"
+	}
+	var set []string
+	for i, name := range names {
+		if v&(1<<i) != 0 {
+			set = append(set, name)
+			v &^= 1 << i
+		}
+	}
+	if v != 0 {
+		set = append(set, fmt.Sprintf("0x%X", v))
+	}
+	return strings.Join(set, "|")
+}
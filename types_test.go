@@ -0,0 +1,106 @@
+package gogt
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestActionTypeNames(t *testing.T) {
+	if int(ActionStarship) != len(actionTypeNames)-1 {
+		t.Fatalf("ActionStarship = %d, but there are %d names", ActionStarship, len(actionTypeNames))
+	}
+	tests := map[ActionType]string{
+		ActionUserDoor:   "UserDoor",
+		ActionLock:       "Lock",
+		ActionBackground: "Background",
+		ActionSeed:       "Seed",
+		ActionClothes:    "Clothes",
+		30:               "ActionType(30)",
+		250:              "ActionType(250)",
+	}
+	for v, want := range tests {
+		if got := v.String(); got != want {
+			t.Errorf("ActionType(%d).String() = %q, want %q", uint8(v), got, want)
+		}
+	}
+}
+
+func TestItemCategoryString(t *testing.T) {
+	tests := map[ItemCategory]string{
+		0:                                    "None",
+		CategoryBeta:                         "Beta",
+		CategoryAutoPickup | CategoryHoliday: "AutoPickup|Holiday",
+		CategoryUntradeable:                  "Untradeable",
+	}
+	for v, want := range tests {
+		if got := v.String(); got != want {
+			t.Errorf("ItemCategory(%d).String() = %q, want %q", uint8(v), got, want)
+		}
+	}
+}
+
+func TestItemJSONNames(t *testing.T) {
+	item := fixture(LatestVersion).Items[1]
+	data, err := json.Marshal(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"action_type":19`, `"action_type_name":"Seed"`,
+		`"collision_type":1`, `"collision_type_name":"Solid"`,
+		`"clothing_type_name":"Mask"`, `"hit_sound_type_name":"Metal"`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("JSON lacks %s", want)
+		}
+	}
+
+	var got Item
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, item) {
+		t.Fatal("JSON round-trip differs")
+	}
+}
+
+func TestItemFlags(t *testing.T) {
+	item := Item{
+		EditableType: EditableDropless | EditablePermanent,
+		ItemCategory: CategoryUntradeable,
+	}
+	flags := item.Flags()
+	if !flags.Has(FlagDropless) || !flags.Has(FlagPermanent|FlagUntradeable) {
+		t.Fatalf("Flags() = %v, missing expected flags", flags)
+	}
+	if flags.Has(FlagBeta) {
+		t.Fatalf("Flags() = %v reports Beta", flags)
+	}
+
+	flags.Set(FlagModOnly | FlagFlippable)
+	flags.Clear(FlagUntradeable)
+	item.SetFlags(flags)
+	if item.EditableType != EditableDropless|EditablePermanent|EditableFlippable {
+		t.Errorf("EditableType = %v", item.EditableType)
+	}
+	if item.ItemCategory != CategoryModOnly {
+		t.Errorf("ItemCategory = %v", item.ItemCategory)
+	}
+	want := []string{"Flippable", "Permanent", "Dropless", "ModOnly"}
+	if got := item.Flags().Names(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+}
+
+func TestItemJSONFlags(t *testing.T) {
+	item := Item{EditableType: EditableSeedless, ItemCategory: CategoryHoliday}
+	data, err := json.Marshal(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"flags":["Seedless","Holiday"]`) {
+		t.Errorf("JSON %s does not list flags by name", data)
+	}
+}
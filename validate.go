@@ -0,0 +1,117 @@
+package gogt
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// An Issue is a problem found by ItemsData.Validate. Files with issues may
+// still encode, but the game client is likely to reject or misread them.
+type Issue struct {
+	Index  int    // index in Items, or -1 for the file as a whole
+	ItemID int    // ID of the item, or -1 for the file as a whole
+	Field  string // Item field concerned, if any
+	Msg    string
+}
+
+func (i Issue) String() string {
+	switch {
+	case i.Index < 0:
+		return i.Msg
+	case i.Field == "":
+		return fmt.Sprintf("item %d (id %d): %s", i.Index, i.ItemID, i.Msg)
+	}
+	return fmt.Sprintf("item %d (id %d) field %s: %s", i.Index, i.ItemID, i.Field, i.Msg)
+}
+
+// Validate checks itemsData for problems the client does not tolerate:
+// an ItemCount that disagrees with Items, item IDs that do not match their
+// index, broken block/seed pairs, strings too long to store, integers too
+// wide for their field, and fields set that the file's version does not
+// store. It returns nil if it finds none.
+func (itemsData *ItemsData) Validate() []Issue {
+	var issues []Issue
+	fileIssue := func(format string, a ...any) {
+		issues = append(issues, Issue{Index: -1, ItemID: -1, Msg: fmt.Sprintf(format, a...)})
+	}
+
+	switch {
+	case itemsData.Version < 0:
+		fileIssue("version %d is negative", itemsData.Version)
+	case itemsData.Version > LatestVersion:
+		fileIssue("version %d is newer than the newest supported, %d", itemsData.Version, LatestVersion)
+	}
+	if itemsData.ItemCount != len(itemsData.Items) {
+		fileIssue("item count is %d but there are %d items", itemsData.ItemCount, len(itemsData.Items))
+	}
+
+	seen := make(map[int]int, len(itemsData.Items))
+	for i := range itemsData.Items {
+		item := &itemsData.Items[i]
+		itemIssue := func(field, format string, a ...any) {
+			issues = append(issues, Issue{Index: i, ItemID: item.ItemID, Field: field, Msg: fmt.Sprintf(format, a...)})
+		}
+
+		if first, dup := seen[item.ItemID]; dup {
+			itemIssue("ItemID", "duplicate of item %d", first)
+		} else {
+			seen[item.ItemID] = i
+			if item.ItemID != i {
+				itemIssue("ItemID", "does not match its index")
+			}
+		}
+		if reason := itemsData.seedPairProblem(item); reason != "" {
+			itemIssue("", "%s", reason)
+		}
+
+		v := reflect.ValueOf(item).Elem()
+		for _, f := range itemSchema {
+			fv := v.Field(f.index)
+			// A negative version is reported once above rather than
+			// against every field.
+			if itemsData.Version >= 0 && f.minVersion > itemsData.Version {
+				if !fv.IsZero() {
+					itemIssue(f.name, "set, but version %d files do not store it (needs %d)", itemsData.Version, f.minVersion)
+				}
+				continue
+			}
+			if f.kind == kindString && fv.Len() > maxStringLen {
+				itemIssue(f.name, "%d bytes long; strings hold at most %d", fv.Len(), maxStringLen)
+			}
+			if err := f.checkRange(fv); err != nil {
+				itemIssue(f.name, "%v", err)
+			}
+		}
+	}
+
+	for _, gap := range idGaps(seen) {
+		if gap[0] == gap[1] {
+			fileIssue("item ID %d is missing", gap[0])
+		} else {
+			fileIssue("item IDs %d-%d are missing", gap[0], gap[1])
+		}
+	}
+	return issues
+}
+
+// idGaps returns the ranges of IDs between 0 and the highest ID in ids that
+// no item uses.
+func idGaps(ids map[int]int) [][2]int {
+	sorted := make([]int, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Ints(sorted)
+	var gaps [][2]int
+	next := 0
+	for _, id := range sorted {
+		if id > next {
+			gaps = append(gaps, [2]int{next, id - 1})
+		}
+		if id >= next {
+			next = id + 1
+		}
+	}
+	return gaps
+}
@@ -0,0 +1,66 @@
+package gogt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateClean(t *testing.T) {
+	itemsData := seedFixture()
+	itemsData.Version = LatestVersion
+	itemsData.ItemCount = len(itemsData.Items)
+	if issues := itemsData.Validate(); issues != nil {
+		t.Fatalf("Validate() = %v, want none", issues)
+	}
+}
+
+func TestValidateVersion(t *testing.T) {
+	for version, want := range map[int]string{
+		-1:                "version -1 is negative",
+		LatestVersion + 1: "version 23 is newer than the newest supported, 22",
+	} {
+		itemsData := seedFixture()
+		itemsData.Version = version
+		itemsData.ItemCount = len(itemsData.Items)
+		issues := itemsData.Validate()
+		if len(issues) != 1 || issues[0].String() != want {
+			t.Errorf("Validate() on version %d = %v, want [%s]", version, issues, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	itemsData := seedFixture()
+	itemsData.Version = 11
+	itemsData.ItemCount = 3
+	itemsData.Items[1].MaxAmount = 300
+	itemsData.Items[1].SeedColor.R = -1
+	itemsData.Items[2].Texture = strings.Repeat("x", maxStringLen+1)
+	itemsData.Items[2].IntVersion13 = 7
+	itemsData.Items[3].ItemID = 2
+	itemsData.Items = append(itemsData.Items, Item{ItemID: 7, ActionType: ActionSeed}, Item{ItemID: 10})
+
+	var got []string
+	for _, issue := range itemsData.Validate() {
+		got = append(got, issue.String())
+	}
+	want := []string{
+		"item count is 3 but there are 6 items",
+		"item 1 (id 1) field MaxAmount: 300 out of range 0-255",
+		"item 1 (id 1) field SeedColor: R: -1 out of range 0-255",
+		"item 2 (id 2): no seed at ID 3",
+		"item 2 (id 2) field Texture: 65536 bytes long; strings hold at most 65535",
+		"item 2 (id 2) field IntVersion13: set, but version 11 files do not store it (needs 13)",
+		"item 3 (id 2) field ItemID: duplicate of item 2",
+		"item 3 (id 2): seed at an even ID",
+		"item 4 (id 7) field ItemID: does not match its index",
+		"item 4 (id 7): no block at ID 6",
+		"item 5 (id 10) field ItemID: does not match its index",
+		"item 5 (id 10): no seed at ID 11",
+		"item IDs 3-6 are missing",
+		"item IDs 8-9 are missing",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("Validate() issues:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}